@@ -0,0 +1,68 @@
+// Package chipaginator integrates paginator with the chi router, including
+// reading the page from route params, e.g /articles/page/{page}.
+package chipaginator
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/purisaurabh/paginator"
+)
+
+// Middleware returns chi middleware that parses the pagination params of
+// every request with NewFromRequest and stores the set in the request's
+// context, to be retrieved with paginator.FromContext. With Option.Parse set
+// to ParseStrict, requests with invalid params are parsed with
+// NewFromRequestStrict and rejected with 400 Bad Request.
+//
+// Route params are only available once a route has matched, so to read them
+// the middleware has to be added to the route with With (or Group) instead of
+// to the router with Use, e.g
+//
+//	r.With(chipaginator.Middleware(pg)).Get("/articles/page/{page}", list)
+func Middleware(p *paginator.Paginator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if p.Options().Parse != paginator.ParseStrict {
+				next.ServeHTTP(w, r.WithContext(paginator.NewContext(r.Context(), NewFromRequest(p, r))))
+				return
+			}
+
+			s, err := NewFromRequestStrict(p, r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(paginator.NewContext(r.Context(), s)))
+		})
+	}
+}
+
+// NewFromRequest returns a new paginator set for the request. The page and
+// per page are read from the route params named after Option.PageParam and
+// Option.PerPageParam, falling back to the query params.
+func NewFromRequest(p *paginator.Paginator, r *http.Request) paginator.Set {
+	return p.NewFromUrl(query(p, r))
+}
+
+// NewFromRequestStrict is like NewFromRequest but returns an error for
+// invalid params, see paginator.NewFromUrlStrict.
+func NewFromRequestStrict(p *paginator.Paginator, r *http.Request) (paginator.Set, error) {
+	return p.NewFromUrlStrict(query(p, r))
+}
+
+// query returns the query params of the request with the route params named
+// after Option.PageParam and Option.PerPageParam set.
+func query(p *paginator.Paginator, r *http.Request) url.Values {
+	var (
+		o = p.Options()
+		q = r.URL.Query()
+	)
+	for _, name := range []string{o.PageParam, o.PerPageParam} {
+		if v := chi.URLParam(r, name); v != "" {
+			q.Set(name, v)
+		}
+	}
+	return q
+}
@@ -0,0 +1,47 @@
+package chipaginator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/purisaurabh/paginator"
+)
+
+func TestMiddleware(t *testing.T) {
+	strict := paginator.Default()
+	strict.Parse = paginator.ParseStrict
+
+	tests := []struct {
+		name     string
+		o        paginator.Option
+		target   string
+		wantCode int
+		page     int
+		perPage  int
+	}{
+		{"route param", paginator.Default(), "/articles/page/3", http.StatusOK, 3, 10},
+		{"route and query params", paginator.Default(), "/articles/page/3?per_page=20", http.StatusOK, 3, 20},
+		{"route param over query param", paginator.Default(), "/articles/page/3?page=5", http.StatusOK, 3, 10},
+		{"fallback", paginator.Default(), "/articles/page/abc", http.StatusOK, 1, 10},
+		{"strict invalid", strict, "/articles/page/abc", http.StatusBadRequest, 0, 0},
+		{"strict invalid query", strict, "/articles/page/2?per_page=10abc", http.StatusBadRequest, 0, 0},
+	}
+	for _, tc := range tests {
+		var got paginator.Set
+		r := chi.NewRouter()
+		r.With(Middleware(paginator.New(tc.o))).Get("/articles/page/{page}", func(w http.ResponseWriter, r *http.Request) {
+			got, _ = paginator.FromContext(r.Context())
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.target, nil))
+		if w.Code != tc.wantCode {
+			t.Errorf("%s: status = %d, want %d", tc.name, w.Code, tc.wantCode)
+		}
+		if got.Page != tc.page || got.PerPage != tc.perPage {
+			t.Errorf("%s: FromContext() = page %d, per page %d, want %d, %d", tc.name, got.Page, got.PerPage, tc.page, tc.perPage)
+		}
+	}
+}
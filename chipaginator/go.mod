@@ -0,0 +1,8 @@
+module github.com/purisaurabh/paginator/chipaginator
+
+go 1.23
+
+require (
+	github.com/go-chi/chi/v5 v5.0.12
+	github.com/purisaurabh/paginator v0.1.0
+)
@@ -0,0 +1,48 @@
+package paginator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ContinuationSource is a source of items paginated by continuation tokens,
+// such as S3's ListObjectsV2 and many other cloud APIs. List returns up to
+// limit items starting at token, which is empty for the first page, and the
+// token of the next page, which is empty after the last page.
+type ContinuationSource[T any] interface {
+	List(ctx context.Context, token string, limit int) (items []T, nextToken string, err error)
+}
+
+// ContinuationFunc adapts a function into a ContinuationSource.
+type ContinuationFunc[T any] func(ctx context.Context, token string, limit int) ([]T, string, error)
+
+// List calls f.
+func (f ContinuationFunc[T]) List(ctx context.Context, token string, limit int) ([]T, string, error) {
+	return f(ctx, token, limit)
+}
+
+// FetchContinuation fetches the items of the cursor's page from src, for
+// proxying continuation token paginated sources through the cursor params of
+// an API. The source's continuation token is wrapped in the cursor tokens
+// given to clients, and the returned next token is empty after the last page.
+// Before cursors are not supported as continuation tokens only go forwards.
+func FetchContinuation[T any](ctx context.Context, src ContinuationSource[T], c Cursor) (items []T, next string, err error) {
+	if c.Before {
+		return nil, "", errors.New("continuation sources can't be paged backwards")
+	}
+
+	token := ""
+	if !c.IsFirst() {
+		var ok bool
+		if token, ok = c.Keys[0].(string); !ok || len(c.Keys) != 1 {
+			return nil, "", fmt.Errorf("%w: not a continuation token", ErrInvalidCursor)
+		}
+	}
+
+	items, nextToken, err := src.List(ctx, token, c.PerPage)
+	if err != nil || nextToken == "" {
+		return items, "", err
+	}
+	return items, c.Next(nextToken), nil
+}
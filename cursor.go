@@ -0,0 +1,273 @@
+package paginator
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Cursor represents a position in a list that is paginated by cursors
+// instead of offsets, which keeps working on large tables and on feeds
+// where items are added while paginating. The position is the sort key
+// values of the last seen item, which are encoded into an opaque token for
+// clients to send back.
+type Cursor struct {
+	// Keys are the sort key values of the last seen item, in sort order.
+	// e.g the created_at and id of the last item for a list sorted by them.
+	// Keys is empty on the first page.
+	Keys []interface{}
+
+	// Before is set for a cursor to page backwards from,
+	// i.e to get the items before the one at Keys.
+	Before bool
+
+	// PerPage is the number of items to fetch.
+	PerPage int
+
+	pg *Paginator
+}
+
+// cursorPayload is the encoded payload of a cursor token.
+type cursorPayload struct {
+	Keys []interface{} `json:"k"`
+}
+
+// NewFromCursor returns a new cursor from the cursor (or before cursor) and
+// per page query params. An error wrapping ErrInvalidCursor is returned if
+// the token is invalid.
+func (p *Paginator) NewFromCursor(q url.Values) (Cursor, error) {
+	perPage := -1
+	if v := q.Get(p.o.PerPageParam); v != p.o.AllowAllParam {
+		var err error
+		if perPage, err = p.strictPerPage(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+
+	var (
+		token  = q.Get(p.o.CursorParam)
+		before = false
+	)
+	if b := q.Get(p.o.BeforeParam); b != "" {
+		token, before = b, true
+	}
+
+	c := Cursor{pg: p}
+	if token != "" {
+		var err error
+		if c, err = p.DecodeCursor(token); err != nil {
+			return Cursor{}, err
+		}
+	}
+	c.Before = before
+	c.PerPage = p.New(1, perPage).PerPage
+	return c, nil
+}
+
+// EncodeCursor returns the opaque token for the keys of the cursor.
+// If Option.CursorSecret is set, the token is signed with it.
+func (p *Paginator) EncodeCursor(c Cursor) string {
+	b, _ := json.Marshal(cursorPayload{Keys: c.Keys})
+	token := base64.RawURLEncoding.EncodeToString(b)
+	if len(p.o.CursorSecret) == 0 {
+		return token
+	}
+	return token + "." + base64.RawURLEncoding.EncodeToString(signature(token, p.o.CursorSecret))
+}
+
+// DecodeCursor returns the cursor for a token created with EncodeCursor.
+// If Option.CursorSecret is set, tokens that are not signed with it are rejected.
+// Keys are JSON encoded in the token, so integer keys are decoded as int64,
+// other numbers as float64 and e.g time.Time keys as RFC 3339 strings.
+// Errors wrap ErrInvalidCursor.
+func (p *Paginator) DecodeCursor(token string) (Cursor, error) {
+	if len(p.o.CursorSecret) > 0 {
+		payload, sig, ok := strings.Cut(token, ".")
+		if !ok {
+			return Cursor{}, fmt.Errorf("%w: unsigned", ErrInvalidCursor)
+		}
+
+		got, err := base64.RawURLEncoding.DecodeString(sig)
+		if err != nil || !hmac.Equal(got, signature(payload, p.o.CursorSecret)) {
+			return Cursor{}, fmt.Errorf("%w: bad signature", ErrInvalidCursor)
+		}
+		token = payload
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: malformed", ErrInvalidCursor)
+	}
+
+	var (
+		pl  cursorPayload
+		dec = json.NewDecoder(bytes.NewReader(b))
+	)
+	dec.UseNumber()
+	if err := dec.Decode(&pl); err != nil {
+		return Cursor{}, fmt.Errorf("%w: malformed", ErrInvalidCursor)
+	}
+
+	for i, k := range pl.Keys {
+		n, ok := k.(json.Number)
+		if !ok {
+			continue
+		}
+		if v, err := n.Int64(); err == nil {
+			pl.Keys[i] = v
+		} else if v, err := n.Float64(); err == nil {
+			pl.Keys[i] = v
+		}
+	}
+	return Cursor{Keys: pl.Keys, pg: p}, nil
+}
+
+// NextPageToken returns the token for the next page after the item with the
+// given sort keys, the last item on the page, or an empty token if hasMore is
+// false as there are no items after the page. hasMore can be found out by
+// fetching one item more than PerPage.
+func (c *Cursor) NextPageToken(hasMore bool, lastKeys ...interface{}) string {
+	if !hasMore {
+		return ""
+	}
+	return c.Next(lastKeys...)
+}
+
+// IsFirst reports whether the cursor is for the first page, i.e it has no keys.
+func (c *Cursor) IsFirst() bool {
+	return len(c.Keys) == 0
+}
+
+// Next returns the token of the cursor for the page after the item with the
+// given sort key values, which is the last item on the current page.
+// Tokens for before cursors are encoded the same way, so the token for the
+// keys of the first item on the page can be used to page backwards.
+func (c *Cursor) Next(keys ...interface{}) string {
+	return c.pg.EncodeCursor(Cursor{Keys: keys})
+}
+
+// signedState is the payload of a signed pagination token.
+type signedState struct {
+	Page    int `json:"page"`
+	PerPage int `json:"per_page"`
+}
+
+// SignState returns a token encoding the page and per page of the set, signed
+// with HMAC-SHA256 using key. Use NewFromSignedToken to verify it and get the
+// set back.
+func (p *Paginator) SignState(s Set, key []byte) string {
+	b, _ := json.Marshal(signedState{Page: s.Page, PerPage: s.PerPage})
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(signature(payload, key))
+}
+
+// NewFromSignedToken returns a new paginator set from a token created with
+// SignState. An error wrapping ErrInvalidCursor is returned if the token is
+// malformed or if its signature does not match key.
+func (p *Paginator) NewFromSignedToken(token string, key []byte) (Set, error) {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return Set{}, fmt.Errorf("%w: malformed pagination token", ErrInvalidCursor)
+	}
+
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, signature(payload, key)) {
+		return Set{}, fmt.Errorf("%w: bad pagination token signature", ErrInvalidCursor)
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return Set{}, fmt.Errorf("%w: malformed pagination token", ErrInvalidCursor)
+	}
+
+	var st signedState
+	if err := json.Unmarshal(b, &st); err != nil {
+		return Set{}, fmt.Errorf("%w: malformed pagination token", ErrInvalidCursor)
+	}
+	return p.New(st.Page, st.PerPage), nil
+}
+
+// signature returns the HMAC-SHA256 of payload with key.
+func signature(payload string, key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
+
+// SetSearchAfter stores the sort values of the last hit of the previous page
+// for "search after" style queries such as Elasticsearch's search_after.
+func (s *Set) SetSearchAfter(vals []interface{}) {
+	s.searchAfter = vals
+}
+
+// SearchAfter returns the sort values stored with SetSearchAfter to fetch the
+// current page with. If none were stored, the values are decoded from the
+// cursor the set was created from (see EncodeSearchAfter). It returns false
+// on the first page or if there are no values.
+func (s *Set) SearchAfter() ([]interface{}, bool) {
+	if s.Page <= 1 {
+		return nil, false
+	}
+	if s.searchAfter != nil {
+		return s.searchAfter, true
+	}
+
+	if s.cursor == "" {
+		return nil, false
+	}
+	c, err := s.pg.DecodeCursor(s.cursor)
+	if err != nil || c.IsFirst() {
+		return nil, false
+	}
+	return c.Keys, true
+}
+
+// EncodeSearchAfter returns a cursor holding the sort values of the last hit
+// on the current page, for clients to send back with the next page so that it
+// can be fetched with SearchAfter.
+func (s *Set) EncodeSearchAfter(vals []interface{}) string {
+	return s.pg.EncodeCursor(Cursor{Keys: vals})
+}
+
+// EncodeKeyset returns a cursor for the next page of a keyset query sorted by
+// a non-unique column, from the sort value and the unique tie breaker (id) of
+// the last row on the current page.
+func (s *Set) EncodeKeyset(sortValue string, tieBreaker int64) string {
+	return s.pg.EncodeCursor(Cursor{Keys: []interface{}{sortValue, tieBreaker}})
+}
+
+// KeysetBounds returns the sort value and tie breaker decoded from the cursor
+// the set was created from, to query the rows after
+// (sort_col, id) > (sortValue, tieBreaker). ok is false if there is no valid
+// cursor, e.g on the first page.
+func (s *Set) KeysetBounds() (sortValue string, tieBreaker int64, ok bool) {
+	if s.cursor == "" {
+		return "", 0, false
+	}
+
+	c, err := s.pg.DecodeCursor(s.cursor)
+	if err != nil || len(c.Keys) != 2 {
+		return "", 0, false
+	}
+
+	sortValue, ok1 := c.Keys[0].(string)
+	tieBreaker, ok2 := c.Keys[1].(int64)
+	if !ok1 || !ok2 {
+		return "", 0, false
+	}
+	return sortValue, tieBreaker, true
+}
+
+// NeedsReverse reports whether the set was created from a before cursor.
+// To page backwards, query the rows before the cursor in reverse sort order,
+// e.g ORDER BY id DESC for a list sorted by id, so that the rows closest to
+// the cursor are fetched first, and then reverse the fetched slice to get
+// the page back in the normal sort order.
+func (s *Set) NeedsReverse() bool {
+	return s.before
+}
@@ -0,0 +1,134 @@
+package paginator
+
+import (
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSearchAfter(t *testing.T) {
+	p := New(Default())
+
+	first := p.New(1, 10)
+	if vals, ok := first.SearchAfter(); ok || vals != nil {
+		t.Errorf("first page: SearchAfter() = %v, %t, want none", vals, ok)
+	}
+
+	token := first.EncodeSearchAfter([]interface{}{"2024-01-02", 42})
+	s := p.NewFromUrl(url.Values{"page": {"2"}, "cursor": {token}})
+	vals, ok := s.SearchAfter()
+	if want := []interface{}{"2024-01-02", int64(42)}; !ok || !slices.Equal(vals, want) {
+		t.Errorf("SearchAfter() = %v, %t, want %v, true", vals, ok, want)
+	}
+
+	// Values stored with SetSearchAfter take precedence over the cursor.
+	s.SetSearchAfter([]interface{}{"2024-01-03", 7})
+	if vals, ok := s.SearchAfter(); !ok || !slices.Equal(vals, []interface{}{"2024-01-03", 7}) {
+		t.Errorf("SearchAfter() after SetSearchAfter = %v, %t", vals, ok)
+	}
+}
+
+func TestKeysetBounds(t *testing.T) {
+	p := New(Default())
+
+	first := p.New(1, 10)
+	if _, _, ok := first.KeysetBounds(); ok {
+		t.Error("first page: KeysetBounds() ok, want no bounds")
+	}
+
+	token := first.EncodeKeyset("2024-01-02T15:04:05Z", 42)
+	s := p.NewFromUrl(url.Values{"page": {"2"}, "cursor": {token}})
+	sortValue, tieBreaker, ok := s.KeysetBounds()
+	if !ok || sortValue != "2024-01-02T15:04:05Z" || tieBreaker != 42 {
+		t.Errorf("KeysetBounds() = %q, %d, %t, want %q, 42, true", sortValue, tieBreaker, ok, "2024-01-02T15:04:05Z")
+	}
+
+	// Cursors of other shapes have no keyset bounds.
+	other := p.EncodeCursor(Cursor{Keys: []interface{}{"a"}})
+	s = p.NewFromUrl(url.Values{"page": {"2"}, "cursor": {other}})
+	if _, _, ok := s.KeysetBounds(); ok {
+		t.Error("single key cursor: KeysetBounds() ok, want no bounds")
+	}
+}
+
+func TestSignState(t *testing.T) {
+	var (
+		p   = New(Default())
+		key = []byte("key")
+	)
+	token := p.SignState(p.New(4, 20), key)
+
+	s, err := p.NewFromSignedToken(token, key)
+	if err != nil || s.Page != 4 || s.PerPage != 20 {
+		t.Errorf("NewFromSignedToken() = page %d, per page %d, %v, want 4, 20, nil", s.Page, s.PerPage, err)
+	}
+
+	tampered := p.SignState(p.New(5, 20), key)
+	tampered = tampered[:strings.IndexByte(tampered, '.')] + token[strings.IndexByte(token, '.'):]
+	for name, tc := range map[string]struct {
+		token string
+		key   []byte
+	}{
+		"tampered":  {tampered, key},
+		"wrong key": {token, []byte("other")},
+		"unsigned":  {token[:strings.IndexByte(token, '.')], key},
+	} {
+		if _, err := p.NewFromSignedToken(tc.token, tc.key); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%s: NewFromSignedToken() err = %v, want %v", name, err, ErrInvalidCursor)
+		}
+	}
+}
+
+func TestNeedsReverse(t *testing.T) {
+	p := New(Default())
+	token := p.EncodeCursor(Cursor{Keys: []interface{}{int64(42)}})
+
+	tests := []struct {
+		q    url.Values
+		want bool
+	}{
+		{url.Values{"before": {token}}, true},
+		{url.Values{"cursor": {token}}, false},
+		{url.Values{}, false},
+	}
+	for _, tc := range tests {
+		if s := p.NewFromUrl(tc.q); s.NeedsReverse() != tc.want {
+			t.Errorf("NewFromUrl(%v).NeedsReverse() = %t, want %t", tc.q, s.NeedsReverse(), tc.want)
+		}
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	for name, secret := range map[string][]byte{"unsigned": nil, "signed": []byte("secret")} {
+		o := Default()
+		o.CursorSecret = secret
+		p := New(o)
+
+		first, err := p.NewFromCursor(url.Values{"per_page": {"20"}})
+		if err != nil || !first.IsFirst() || first.PerPage != 20 {
+			t.Fatalf("%s: NewFromCursor() = %+v, %v, want a first page of 20", name, first, err)
+		}
+
+		token := first.NextPageToken(true, "2024-01-02", int64(42), 1.5)
+		tests := []struct {
+			q      url.Values
+			before bool
+		}{
+			{url.Values{"cursor": {token}, "per_page": {"20"}}, false},
+			{url.Values{"before": {token}, "per_page": {"20"}}, true},
+		}
+		for _, tc := range tests {
+			c, err := p.NewFromCursor(tc.q)
+			if err != nil || c.Before != tc.before || c.PerPage != 20 ||
+				!slices.Equal(c.Keys, []interface{}{"2024-01-02", int64(42), 1.5}) {
+				t.Errorf("%s: NewFromCursor(%v) = %+v, %v", name, tc.q, c, err)
+			}
+		}
+
+		if got := first.NextPageToken(false, "2024-01-02", int64(42)); got != "" {
+			t.Errorf("%s: NextPageToken(false) = %q, want none", name, got)
+		}
+	}
+}
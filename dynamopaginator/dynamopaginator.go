@@ -0,0 +1,86 @@
+// Package dynamopaginator bridges DynamoDB's LastEvaluatedKey and the
+// paginator's opaque cursor tokens, so that DynamoDB backed APIs expose the
+// same cursor params as SQL backed ones.
+package dynamopaginator
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/purisaurabh/paginator"
+)
+
+// ErrInvalidKey is returned for keys that can't be encoded into or decoded
+// from a cursor.
+var ErrInvalidKey = errors.New("invalid dynamodb key")
+
+// ExclusiveStartKey returns the key to set as the ExclusiveStartKey of a
+// Query or Scan for the cursor's page. It is nil on the first page.
+func ExclusiveStartKey(c paginator.Cursor) (map[string]types.AttributeValue, error) {
+	if c.IsFirst() {
+		return nil, nil
+	}
+
+	m, ok := c.Keys[0].(map[string]interface{})
+	if !ok || len(c.Keys) != 1 {
+		return nil, ErrInvalidKey
+	}
+
+	key := make(map[string]types.AttributeValue, len(m))
+	for name, v := range m {
+		av, ok := v.(map[string]interface{})
+		if !ok || len(av) != 1 {
+			return nil, fmt.Errorf("%w: attribute %q", ErrInvalidKey, name)
+		}
+
+		for typ, val := range av {
+			s, ok := val.(string)
+			if !ok {
+				return nil, fmt.Errorf("%w: attribute %q", ErrInvalidKey, name)
+			}
+
+			switch typ {
+			case "S":
+				key[name] = &types.AttributeValueMemberS{Value: s}
+			case "N":
+				key[name] = &types.AttributeValueMemberN{Value: s}
+			case "B":
+				b, err := base64.StdEncoding.DecodeString(s)
+				if err != nil {
+					return nil, fmt.Errorf("%w: attribute %q", ErrInvalidKey, name)
+				}
+				key[name] = &types.AttributeValueMemberB{Value: b}
+			default:
+				return nil, fmt.Errorf("%w: attribute %q", ErrInvalidKey, name)
+			}
+		}
+	}
+	return key, nil
+}
+
+// NextToken returns the cursor token for the page after the one that
+// returned the LastEvaluatedKey lastKey. It is empty when lastKey is empty,
+// i.e when there are no more pages. Key attributes are always strings,
+// numbers or binary, other types are rejected.
+func NextToken(c paginator.Cursor, lastKey map[string]types.AttributeValue) (string, error) {
+	if len(lastKey) == 0 {
+		return "", nil
+	}
+
+	m := make(map[string]interface{}, len(lastKey))
+	for name, av := range lastKey {
+		switch v := av.(type) {
+		case *types.AttributeValueMemberS:
+			m[name] = map[string]string{"S": v.Value}
+		case *types.AttributeValueMemberN:
+			m[name] = map[string]string{"N": v.Value}
+		case *types.AttributeValueMemberB:
+			m[name] = map[string]string{"B": base64.StdEncoding.EncodeToString(v.Value)}
+		default:
+			return "", fmt.Errorf("%w: attribute %q", ErrInvalidKey, name)
+		}
+	}
+	return c.Next(m), nil
+}
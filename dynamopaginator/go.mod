@@ -0,0 +1,10 @@
+module github.com/purisaurabh/paginator/dynamopaginator
+
+go 1.23
+
+require (
+	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.34.0
+	github.com/purisaurabh/paginator v0.1.0
+)
+
+require github.com/aws/smithy-go v1.20.2 // indirect
@@ -0,0 +1,69 @@
+// Package echopaginator integrates paginator with the Echo web framework.
+package echopaginator
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/purisaurabh/paginator"
+)
+
+// contextKey is the Echo context key of the state stored by Middleware.
+const contextKey = "paginator.set"
+
+// state is the set of a request and whether its total has been set.
+type state struct {
+	set      paginator.Set
+	hasTotal bool
+}
+
+// Middleware returns Echo middleware that parses and validates the pagination
+// params of every request with NewFromRequestStrict and stores the set in the
+// Echo context, to be retrieved with FromEchoContext. Requests with invalid
+// params are rejected with 400 Bad Request.
+//
+// Once the handler has given the total with SetTotal, the pagination values
+// of the set are written to the response headers with Set.WriteHeaders.
+// Without a total, no headers are written.
+func Middleware(p *paginator.Paginator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			s, err := p.NewFromRequestStrict(c.Request())
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
+
+			st := &state{set: s}
+			c.Set(contextKey, st)
+			c.Response().Before(func() {
+				if st.hasTotal {
+					st.set.WriteHeaders(c.Response())
+				}
+			})
+			return next(c)
+		}
+	}
+}
+
+// FromEchoContext returns the set stored by Middleware. It returns a zero
+// Set if the middleware did not run for the request.
+//
+// The returned set is a copy, so calling SetTotal on it does not change the
+// response headers. Use the package's SetTotal for that, and call
+// FromEchoContext after it to get a set with the total.
+func FromEchoContext(c echo.Context) paginator.Set {
+	if st, ok := c.Get(contextKey).(*state); ok {
+		return st.set
+	}
+	return paginator.Set{}
+}
+
+// SetTotal sets the total on the set stored by Middleware, so that it is
+// written to the response headers along with the total pages. It does
+// nothing if the middleware did not run for the request.
+func SetTotal(c echo.Context, total int) {
+	if st, ok := c.Get(contextKey).(*state); ok {
+		st.set.SetTotal(total)
+		st.hasTotal = true
+	}
+}
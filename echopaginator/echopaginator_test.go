@@ -0,0 +1,55 @@
+package echopaginator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/purisaurabh/paginator"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		total     int
+		setTotal  bool
+		wantCode  int
+		wantTotal string
+		wantPage  string
+	}{
+		{"total", "/?page=2&per_page=10", 35, true, http.StatusOK, "35", "2"},
+		{"no total", "/?page=2", 0, false, http.StatusOK, "", ""},
+		{"zero total", "/", 0, true, http.StatusOK, "0", "1"},
+		{"invalid", "/?page=abc", 0, false, http.StatusBadRequest, "", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(Middleware(paginator.New(paginator.Default())))
+			e.GET("/", func(c echo.Context) error {
+				if tc.setTotal {
+					SetTotal(c, tc.total)
+				}
+				if s := FromEchoContext(c); tc.setTotal && s.Total != tc.total {
+					t.Errorf("FromEchoContext().Total = %d, want %d", s.Total, tc.total)
+				}
+				return c.String(http.StatusOK, "ok")
+			})
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+			if rec.Code != tc.wantCode {
+				t.Errorf("status = %d, want %d", rec.Code, tc.wantCode)
+			}
+			if got := rec.Header().Get("X-Total-Count"); got != tc.wantTotal {
+				t.Errorf("X-Total-Count = %q, want %q", got, tc.wantTotal)
+			}
+			if got := rec.Header().Get("X-Page"); got != tc.wantPage {
+				t.Errorf("X-Page = %q, want %q", got, tc.wantPage)
+			}
+		})
+	}
+}
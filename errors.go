@@ -0,0 +1,19 @@
+package paginator
+
+import "errors"
+
+// Errors returned for invalid pagination params.
+var (
+	ErrInvalidPage       = errors.New("invalid page")
+	ErrInvalidPerPage    = errors.New("invalid per page")
+	ErrPerPageExceedsMax = errors.New("per page exceeds maximum")
+	ErrInvalidRange      = errors.New("invalid range")
+	ErrInvalidOffset     = errors.New("invalid offset")
+	ErrPageExceedsMax    = errors.New("page exceeds maximum")
+	ErrOffsetExceedsMax  = errors.New("offset exceeds maximum")
+	ErrInvalidCursor     = errors.New("invalid cursor")
+)
+
+// ErrInvalidOption is returned by Option.Validate and NewStrict for invalid
+// values of an option.
+var ErrInvalidOption = errors.New("invalid option")
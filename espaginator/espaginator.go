@@ -0,0 +1,41 @@
+// Package espaginator generates the pagination fields of Elasticsearch
+// search request bodies.
+package espaginator
+
+import (
+	"errors"
+
+	"github.com/purisaurabh/paginator"
+)
+
+// MaxResultWindow is the default index.max_result_window of Elasticsearch,
+// beyond which from+size searches are rejected.
+const MaxResultWindow = 10000
+
+// ErrDeepPage is returned for pages beyond MaxResultWindow that have no
+// search_after values to be fetched with.
+var ErrDeepPage = errors.New("page is beyond the max result window and has no search_after values")
+
+// Params returns the pagination fields of the search body for the set's page.
+// Pages within MaxResultWindow are fetched with from and size. Deeper pages
+// are fetched with search_after, using the sort values of the last hit of the
+// previous page from Set.SearchAfter, i.e from the cursor the set was created
+// from. Give clients the cursor for the next page with Set.EncodeSearchAfter.
+// The search must be sorted by a unique tie breaker for search_after to work.
+func Params(s paginator.Set) (map[string]interface{}, error) {
+	if (s.Limit > 0 || s.Empty) && s.Offset+s.Limit <= MaxResultWindow {
+		return map[string]interface{}{
+			"from": s.Offset,
+			"size": s.Limit,
+		}, nil
+	}
+
+	sa, ok := s.SearchAfter()
+	if !ok {
+		return nil, ErrDeepPage
+	}
+	return map[string]interface{}{
+		"size":         s.Limit,
+		"search_after": sa,
+	}, nil
+}
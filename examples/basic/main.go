@@ -0,0 +1,40 @@
+// Command basic serves a paginated list of items over HTTP using paginator.
+//
+//	go run ./examples/basic
+//	curl 'localhost:8080/things?page=3&per_page=5'
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/purisaurabh/paginator"
+)
+
+func main() {
+	// Some items to paginate through.
+	items := make([]string, 95)
+	for i := range items {
+		items[i] = fmt.Sprintf("thing %d", i+1)
+	}
+
+	pg := paginator.New(paginator.Default())
+
+	http.HandleFunc("/things", func(w http.ResponseWriter, r *http.Request) {
+		p := pg.NewFromUrl(r.URL.Query())
+
+		// A database query would use p.Offset and p.Limit instead and
+		// call p.SetTotal with the total count.
+		page := paginator.PaginateSliceTotal(items, &p)
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		for _, it := range page {
+			fmt.Fprintf(w, "<p>%s</p>\n", it)
+		}
+		fmt.Fprintln(w, p.HTML("/things?page=%d"))
+	})
+
+	log.Println("listening on :8080")
+	log.Fatal(http.ListenAndServe(":8080", nil))
+}
@@ -0,0 +1,205 @@
+package paginator
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// CountdownPages returns the page numbers in the current series mapped
+// to their countdown equivalents (TotalPages - page + 1) for displaying
+// lists that count down from N to 1. Offsets and limits are unaffected.
+func (s *Set) CountdownPages() []int {
+	out := make([]int, 0, len(s.Pages))
+	for _, p := range s.Pages {
+		out = append(out, s.TotalPages-p+1)
+	}
+	return out
+}
+
+// PrefetchURLs returns the URLs of up to ahead pages following the current
+// page, for warming caches. The list is clamped to TotalPages.
+func (s *Set) PrefetchURLs(uri string, ahead int) []string {
+	last := s.Page + ahead
+	if last > s.TotalPages {
+		last = s.TotalPages
+	}
+
+	out := []string{}
+	for p := s.Page + 1; p <= last; p++ {
+		out = append(out, s.pageURL(uri, p))
+	}
+	return out
+}
+
+// metricPageBuckets are the last pages of the page buckets used in
+// MetricLabels. Pages past the last bucket are in the "overflow" bucket.
+var metricPageBuckets = []int{1, 5, 10, 50, 100, 500, 1000}
+
+// MetricLabels returns low cardinality labels describing the set for tagging
+// metrics. The page is bucketed into exponentially growing ranges, e.g page 47
+// is "11-50", and pages past 1000 are "overflow", so that the page bucket
+// has a fixed number of values.
+func (s *Set) MetricLabels() map[string]string {
+	bucket, first := "overflow", 1
+	for _, last := range metricPageBuckets {
+		if s.Page <= last {
+			bucket = strconv.Itoa(first) + "-" + strconv.Itoa(last)
+			if first == last {
+				bucket = strconv.Itoa(last)
+			}
+			break
+		}
+		first = last + 1
+	}
+
+	return map[string]string{
+		"page_bucket": bucket,
+		"per_page":    strconv.Itoa(s.PerPage),
+	}
+}
+
+// ParamDiff returns the query params that change when navigating from the
+// current page to targetPage, e.g {"page": "4"}, or {"offset": "60"} for
+// Option.LimitOffset, for merging into existing router state. The map is
+// empty if targetPage is the current page.
+func (s *Set) ParamDiff(targetPage int) map[string]string {
+	out := map[string]string{}
+	if targetPage == s.Page {
+		return out
+	}
+
+	if s.pg.o.LimitOffset {
+		out[s.pg.o.OffsetParam] = strconv.Itoa(s.pageOffset(targetPage))
+		return out
+	}
+
+	param := s.pg.o.PageParam
+	if param == "" {
+		param = "page"
+	}
+	out[param] = strconv.Itoa(s.paramPage(targetPage))
+	return out
+}
+
+// Siren returns a Siren hypermedia entity fragment with self, first, prev,
+// next and last links for the set. Links that do not apply to the current
+// page are omitted.
+func (s *Set) Siren(uri string) map[string]interface{} {
+	var (
+		last  = s.lastPage()
+		links = []map[string]interface{}{}
+	)
+
+	add := func(rel string, page int) {
+		links = append(links, map[string]interface{}{
+			"rel":  []string{rel},
+			"href": s.pageURL(uri, page),
+		})
+	}
+
+	add("self", s.Page)
+	add("first", 1)
+	if s.Page > 1 {
+		add("prev", s.Page-1)
+	}
+	if s.Page < last {
+		add("next", s.Page+1)
+	}
+	add("last", last)
+
+	return map[string]interface{}{
+		"properties": map[string]interface{}{
+			"page":        s.Page,
+			"per_page":    s.PerPage,
+			"total_pages": s.TotalPages,
+			"total":       s.Total,
+		},
+		"links": links,
+	}
+}
+
+// AlgoliaMeta returns the pagination values in the shape of an Algolia search
+// response. Note that Algolia's page numbers start at 0.
+func (s *Set) AlgoliaMeta() map[string]interface{} {
+	return map[string]interface{}{
+		"page":        s.Page - 1,
+		"nbPages":     s.TotalPages,
+		"hitsPerPage": s.PerPage,
+		"nbHits":      s.Total,
+	}
+}
+
+// HALLink is a link of a HAL resource.
+type HALLink struct {
+	Href string `json:"href"`
+}
+
+// HALLinks returns HAL style self, first, prev, next and last links for the
+// set, to be embedded as the _links of a resource. The hrefs are baseURL with
+// the page query params set. Links that do not apply to the current page are
+// omitted. It returns an error if baseURL can't be parsed.
+func (s *Set) HALLinks(baseURL string) (map[string]HALLink, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		last  = s.lastPage()
+		links = map[string]HALLink{
+			"self":  {Href: s.linkURL(u, s.Page)},
+			"first": {Href: s.linkURL(u, 1)},
+			"last":  {Href: s.linkURL(u, last)},
+		}
+	)
+	if s.Page > 1 {
+		links["prev"] = HALLink{Href: s.linkURL(u, s.Page-1)}
+	}
+	if s.Page < last {
+		links["next"] = HALLink{Href: s.linkURL(u, s.Page+1)}
+	}
+	return links, nil
+}
+
+// Meta is the pagination metadata of a set for API responses, see Set.Meta.
+type Meta struct {
+	Page       int    `json:"page" xml:"page"`
+	PerPage    int    `json:"per_page" xml:"per_page"`
+	TotalPages int    `json:"total_pages" xml:"total_pages"`
+	Total      int    `json:"total" xml:"total"`
+	From       int    `json:"from" xml:"from"`
+	To         int    `json:"to" xml:"to"`
+	HasPrev    bool   `json:"has_prev" xml:"has_prev"`
+	HasNext    bool   `json:"has_next" xml:"has_next"`
+	PrevURL    string `json:"prev_url,omitempty" xml:"prev_url,omitempty"`
+	NextURL    string `json:"next_url,omitempty" xml:"next_url,omitempty"`
+}
+
+// Meta returns the complete pagination metadata of the set to embed in API
+// responses: the page values, the From and To item indices, whether there
+// are previous and next pages and their URLs, which are baseURL with the page
+// query param set. It returns an error if baseURL can't be parsed. Call it
+// after SetTotal.
+func (s *Set) Meta(baseURL string) (Meta, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return Meta{}, err
+	}
+	return s.meta(u), nil
+}
+
+// meta returns the Meta of the set with URLs based on u.
+func (s *Set) meta(u *url.URL) Meta {
+	return Meta{
+		Page:       s.Page,
+		PerPage:    s.PerPage,
+		TotalPages: s.TotalPages,
+		Total:      s.Total,
+		From:       s.From(),
+		To:         s.To(),
+		HasPrev:    s.HasPrev(),
+		HasNext:    s.HasNext(),
+		PrevURL:    s.PrevURL(u),
+		NextURL:    s.NextURL(u),
+	}
+}
@@ -0,0 +1,129 @@
+package paginator
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestCountdownPages(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 5
+	s := BuildSet(10, 10, 300, o)
+
+	if got, want := s.Pages, []int{8, 9, 10, 11, 12}; !slices.Equal(got, want) {
+		t.Fatalf("Pages = %v, want %v", got, want)
+	}
+	if got, want := s.CountdownPages(), []int{23, 22, 21, 20, 19}; !slices.Equal(got, want) {
+		t.Errorf("CountdownPages() = %v, want %v", got, want)
+	}
+	if s.Offset != 90 || s.Limit != 10 {
+		t.Errorf("Offset, Limit = %d, %d, want 90, 10", s.Offset, s.Limit)
+	}
+}
+
+func TestPrefetchURLs(t *testing.T) {
+	tests := []struct {
+		page int
+		want []string
+	}{
+		{4, []string{"/p?page=5", "/p?page=6", "/p?page=7"}},
+		{9, []string{"/p?page=10"}},
+		{10, []string{}},
+	}
+	for _, tc := range tests {
+		s := BuildSet(tc.page, 10, 100, Default())
+		if got := s.PrefetchURLs("/p?page=%d", 3); !slices.Equal(got, tc.want) {
+			t.Errorf("page %d: PrefetchURLs() = %v, want %v", tc.page, got, tc.want)
+		}
+	}
+}
+
+func TestMetricLabels(t *testing.T) {
+	tests := []struct {
+		page int
+		want string
+	}{
+		{1, "1"},
+		{2, "2-5"},
+		{7, "6-10"},
+		{47, "11-50"},
+		{50, "11-50"},
+		{51, "51-100"},
+		{1000, "501-1000"},
+		{1001, "overflow"},
+		{math.MaxInt / 20, "overflow"},
+	}
+	for _, tc := range tests {
+		s := New(Default()).New(tc.page, 20)
+		got := s.MetricLabels()
+		if got["page_bucket"] != tc.want || got["per_page"] != "20" {
+			t.Errorf("page %d: MetricLabels() = %v, want page_bucket %s and per_page 20", tc.page, got, tc.want)
+		}
+	}
+
+	buckets := map[string]bool{}
+	for page := 1; page <= 5000; page++ {
+		s := New(Default()).New(page, 20)
+		buckets[s.MetricLabels()["page_bucket"]] = true
+	}
+	if len(buckets) != len(metricPageBuckets)+1 {
+		t.Errorf("pages 1 to 5000 have %d page buckets, want %d", len(buckets), len(metricPageBuckets)+1)
+	}
+}
+
+func TestParamDiff(t *testing.T) {
+	s := BuildSet(3, 10, 100, Default())
+
+	tests := []struct {
+		target int
+		want   map[string]string
+	}{
+		{4, map[string]string{"page": "4"}},
+		{1, map[string]string{"page": "1"}},
+		{3, map[string]string{}},
+	}
+	for _, tc := range tests {
+		if got := s.ParamDiff(tc.target); !maps.Equal(got, tc.want) {
+			t.Errorf("ParamDiff(%d) = %v, want %v", tc.target, got, tc.want)
+		}
+	}
+
+	o := Default()
+	o.LimitOffset, o.LimitParam, o.OffsetParam = true, "limit", "offset"
+	s = BuildSet(3, 10, 100, o)
+	if got, want := s.ParamDiff(5), map[string]string{"offset": "40"}; !maps.Equal(got, want) {
+		t.Errorf("limit/offset ParamDiff(5) = %v, want %v", got, want)
+	}
+}
+
+func TestSiren(t *testing.T) {
+	tests := []struct {
+		page int
+		want []string
+	}{
+		{3, []string{"self /p?page=3", "first /p?page=1", "prev /p?page=2", "next /p?page=4", "last /p?page=5"}},
+		{1, []string{"self /p?page=1", "first /p?page=1", "next /p?page=2", "last /p?page=5"}},
+		{5, []string{"self /p?page=5", "first /p?page=1", "prev /p?page=4", "last /p?page=5"}},
+	}
+	for _, tc := range tests {
+		s := BuildSet(tc.page, 10, 50, Default())
+		var got []string
+		for _, l := range s.Siren("/p?page=%d")["links"].([]map[string]interface{}) {
+			got = append(got, l["rel"].([]string)[0]+" "+l["href"].(string))
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("page %d: Siren() links = %v, want %v", tc.page, got, tc.want)
+		}
+	}
+}
+
+func TestAlgoliaMeta(t *testing.T) {
+	s := BuildSet(3, 20, 95, Default())
+
+	want := map[string]interface{}{"page": 2, "nbPages": 5, "hitsPerPage": 20, "nbHits": 95}
+	if got := s.AlgoliaMeta(); !maps.Equal(got, want) {
+		t.Errorf("AlgoliaMeta() = %v, want %v", got, want)
+	}
+}
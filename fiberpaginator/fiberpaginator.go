@@ -0,0 +1,45 @@
+// Package fiberpaginator integrates paginator with the Fiber web framework,
+// which does not use net/http handlers.
+package fiberpaginator
+
+import (
+	"net/url"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/purisaurabh/paginator"
+)
+
+// localsKey is the Fiber locals key of the set stored by Middleware.
+const localsKey = "paginator.set"
+
+// Middleware returns Fiber middleware that parses and validates the
+// pagination params of every request with NewFromUrlStrict and stores the set
+// in the request's locals, to be retrieved with FromFiberCtx. Requests with
+// invalid params are rejected with 400 Bad Request.
+func Middleware(p *paginator.Paginator) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		s, err := p.NewFromUrlStrict(Query(c))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+
+		c.Locals(localsKey, s)
+		return c.Next()
+	}
+}
+
+// FromFiberCtx returns the set stored by Middleware. It returns a zero Set
+// if the middleware did not run for the request.
+func FromFiberCtx(c *fiber.Ctx) paginator.Set {
+	s, _ := c.Locals(localsKey).(paginator.Set)
+	return s
+}
+
+// Query returns the fasthttp query args of the request as url.Values.
+func Query(c *fiber.Ctx) url.Values {
+	q := url.Values{}
+	c.Context().QueryArgs().VisitAll(func(k, v []byte) {
+		q.Add(string(k), string(v))
+	})
+	return q
+}
@@ -0,0 +1,44 @@
+package fiberpaginator
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/purisaurabh/paginator"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		wantCode int
+		page     int
+		perPage  int
+	}{
+		{"defaults", "/", fiber.StatusOK, 1, 10},
+		{"valid", "/?page=3&per_page=20", fiber.StatusOK, 3, 20},
+		{"invalid page", "/?page=abc", fiber.StatusBadRequest, 0, 0},
+		{"per page over max", "/?per_page=500", fiber.StatusBadRequest, 0, 0},
+	}
+	for _, tc := range tests {
+		var got paginator.Set
+		app := fiber.New()
+		app.Use(Middleware(paginator.New(paginator.Default())))
+		app.Get("/", func(c *fiber.Ctx) error {
+			got = FromFiberCtx(c)
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tc.target, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != tc.wantCode {
+			t.Errorf("%s: status = %d, want %d", tc.name, resp.StatusCode, tc.wantCode)
+		}
+		if got.Page != tc.page || got.PerPage != tc.perPage {
+			t.Errorf("%s: FromFiberCtx() = page %d, per page %d, want %d, %d", tc.name, got.Page, got.PerPage, tc.page, tc.perPage)
+		}
+	}
+}
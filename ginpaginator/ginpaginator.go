@@ -0,0 +1,33 @@
+// Package ginpaginator integrates paginator with the Gin web framework.
+package ginpaginator
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/purisaurabh/paginator"
+)
+
+// Middleware returns Gin middleware that parses and validates the pagination
+// params of every request with NewFromRequestStrict and stores the set in the
+// request's context, to be retrieved with FromGinContext. Requests with
+// invalid params are aborted with 400 Bad Request.
+func Middleware(p *paginator.Paginator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		s, err := p.NewFromRequestStrict(c.Request)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Request = c.Request.WithContext(paginator.NewContext(c.Request.Context(), s))
+		c.Next()
+	}
+}
+
+// FromGinContext returns the set stored by Middleware. It returns a zero
+// Set if the middleware did not run for the request.
+func FromGinContext(c *gin.Context) paginator.Set {
+	s, _ := paginator.FromContext(c.Request.Context())
+	return s
+}
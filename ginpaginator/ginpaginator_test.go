@@ -0,0 +1,45 @@
+package ginpaginator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/purisaurabh/paginator"
+)
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		target   string
+		wantCode int
+		page     int
+		perPage  int
+	}{
+		{"defaults", "/", http.StatusOK, 1, 10},
+		{"valid", "/?page=3&per_page=20", http.StatusOK, 3, 20},
+		{"invalid page", "/?page=abc", http.StatusBadRequest, 0, 0},
+		{"per page over max", "/?per_page=500", http.StatusBadRequest, 0, 0},
+	}
+	for _, tc := range tests {
+		var got paginator.Set
+		r := gin.New()
+		r.Use(Middleware(paginator.New(paginator.Default())))
+		r.GET("/", func(c *gin.Context) {
+			got = FromGinContext(c)
+			c.Status(http.StatusOK)
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.target, nil))
+		if w.Code != tc.wantCode {
+			t.Errorf("%s: status = %d, want %d", tc.name, w.Code, tc.wantCode)
+		}
+		if got.Page != tc.page || got.PerPage != tc.perPage {
+			t.Errorf("%s: FromGinContext() = page %d, per page %d, want %d, %d", tc.name, got.Page, got.PerPage, tc.page, tc.perPage)
+		}
+	}
+}
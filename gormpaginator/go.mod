@@ -0,0 +1,13 @@
+module github.com/purisaurabh/paginator/gormpaginator
+
+go 1.23
+
+require (
+	github.com/purisaurabh/paginator v0.1.0
+	gorm.io/gorm v1.25.10
+)
+
+require (
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+)
@@ -0,0 +1,64 @@
+// Package gormpaginator integrates paginator with GORM. It is a separate
+// module so that importing the paginator package does not pull in GORM.
+package gormpaginator
+
+import (
+	"github.com/purisaurabh/paginator"
+	"gorm.io/gorm"
+)
+
+// Scope returns a GORM scope that applies the Offset and Limit of the set,
+// e.g db.Scopes(gormpaginator.Scope(set)).Find(&items). An Empty set is
+// queried with LIMIT 0.
+func Scope(s paginator.Set) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Offset(s.Offset)
+		if s.Limit > 0 || s.Empty {
+			db = db.Limit(s.Limit)
+		}
+		return db
+	}
+}
+
+// CursorScope returns a GORM scope that applies the keyset conditions, order
+// and limit of the cursor. If the cursor's keys do not match the keyset, the
+// error is added to the query.
+func CursorScope(c paginator.Cursor, ks *paginator.Keyset) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		where, args, err := ks.Where(c)
+		if err != nil {
+			db.AddError(err)
+			return db
+		}
+
+		if where != "" {
+			db = db.Where(where, args...)
+		}
+		db = db.Order(ks.OrderBy(c))
+		if c.PerPage > 0 {
+			db = db.Limit(c.PerPage)
+		}
+		return db
+	}
+}
+
+// Find counts the rows matching the query to set the total of the set, and
+// then finds the rows on the set's page into dest. If the query has no model,
+// dest is used as the model.
+func Find(db *gorm.DB, s *paginator.Set, dest interface{}) error {
+	if db.Statement.Model == nil {
+		db = db.Model(dest)
+	}
+
+	// Start a new session so that the count and find queries don't share
+	// their conditions.
+	db = db.Session(&gorm.Session{})
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return err
+	}
+	s.SetTotal(int(total))
+
+	return db.Scopes(Scope(*s)).Find(dest).Error
+}
@@ -0,0 +1,54 @@
+// Package grpcpaginator maps the AIP-158 page_size, page_token and
+// next_page_token fields of gRPC List methods onto paginator's cursors, so
+// that gRPC and REST services share one pagination engine.
+//
+// pagination.proto defines PageRequest and PageResponse messages with these
+// fields. The helpers take interfaces implemented by the generated code of
+// any message with the fields, so they work with the messages of your own
+// protos as well.
+package grpcpaginator
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/purisaurabh/paginator"
+)
+
+// PageRequest is implemented by request messages with page_size and
+// page_token fields.
+type PageRequest interface {
+	GetPageSize() int32
+	GetPageToken() string
+}
+
+// ErrNegativePageSize is returned for a negative page_size, which should be
+// responded to with codes.InvalidArgument.
+var ErrNegativePageSize = errors.New("page_size must not be negative")
+
+// NewCursor returns the cursor for a request. A page_size of 0 gets the
+// default per page and larger sizes are clamped to MaxPerPage, as AIP-158
+// requires. Errors for an invalid page_token or page_size should be responded
+// to with codes.InvalidArgument.
+func NewCursor(p *paginator.Paginator, req PageRequest) (paginator.Cursor, error) {
+	if req.GetPageSize() < 0 {
+		return paginator.Cursor{}, ErrNegativePageSize
+	}
+
+	c, _ := p.NewFromCursor(url.Values{})
+	if t := req.GetPageToken(); t != "" {
+		var err error
+		if c, err = p.DecodeCursor(t); err != nil {
+			return paginator.Cursor{}, err
+		}
+	}
+	c.PerPage = p.New(1, int(req.GetPageSize())).PerPage
+	return c, nil
+}
+
+// NextPageToken returns the next_page_token for the response, which is empty
+// when there are no more items as AIP-158 requires. See
+// paginator.Cursor.NextPageToken.
+func NextPageToken(c paginator.Cursor, hasMore bool, lastKeys ...interface{}) string {
+	return c.NextPageToken(hasMore, lastKeys...)
+}
@@ -0,0 +1,384 @@
+package paginator
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// HTML prints pagination as HTML using Option.Template, or DefaultTemplate
+// if it isn't set. The default template prints a <nav> landmark, marks the
+// current page with aria-current and the adjacent pages with rel="prev" and
+// rel="next". If Option.RTL is set, the <nav> has dir="rtl" for the browser to
+// lay out the pagination right to left. It returns an empty string if the
+// template fails, use Render or RenderWith to get the error.
+func (s *Set) HTML(uri string) string {
+	out, _ := s.RenderWith(s.template(), uri)
+	return out
+}
+
+// join prints the rendered items of a pagination separated by spaces in a
+// <nav> landmark, with dir="rtl" for RTL.
+func (s *Set) join(items []string) string {
+	var b bytes.Buffer
+	dir := ""
+	if s.pg.o.RTL {
+		dir = ` dir="rtl"`
+	}
+	b.WriteString(`<nav class="` + s.pg.o.CSSClasses.Wrapper + `" aria-label="Pagination"` + dir + `>`)
+	for _, it := range items {
+		b.WriteString(it)
+		b.WriteString(" ")
+	}
+	b.WriteString(`</nav>`)
+	return b.String()
+}
+
+// href returns the URL of page formatted with uri, escaped for an href
+// attribute.
+func (s *Set) href(uri string, page int) string {
+	return html.EscapeString(s.pageURL(uri, page))
+}
+
+// gapBefore reports whether there is a gap in the page number series before
+// the i-th page, which is printed as an ellipsis.
+func (s *Set) gapBefore(i int) bool {
+	return i > 0 && s.Pages[i]-s.Pages[i-1] > 1
+}
+
+// SVGProgress renders the position of the current page as an SVG progress bar
+// of the given dimensions. The filled width is proportional to Page/TotalPages.
+// An empty bar is rendered when there are no pages.
+func (s *Set) SVGProgress(width, height int) string {
+	fill := 0
+	if s.TotalPages > 0 {
+		fill = width * s.Page / s.TotalPages
+		if fill > width {
+			fill = width
+		}
+	}
+
+	var b bytes.Buffer
+	b.WriteString(fmt.Sprintf(`<svg class="pg-progress" xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		width, height, width, height))
+	b.WriteString(fmt.Sprintf(`<rect class="pg-progress-track" width="%d" height="%d" fill="#eee"/>`, width, height))
+	b.WriteString(fmt.Sprintf(`<rect class="pg-progress-fill" width="%d" height="%d" fill="#666"/>`, fill, height))
+	b.WriteString(`</svg>`)
+	return b.String()
+}
+
+// HTMLSkeleton prints a loading placeholder for the pagination with
+// NumPageNums items and no links, for use before the total is known. It has
+// the same <nav> landmark as HTML, marked busy and hidden from assistive
+// technology until it's replaced.
+func (s *Set) HTMLSkeleton() string {
+	var b bytes.Buffer
+	b.WriteString(`<nav class="` + s.pg.o.CSSClasses.Wrapper + `" aria-label="Pagination" aria-busy="true" aria-hidden="true">`)
+	for i := 0; i < s.pg.o.NumPageNums; i++ {
+		b.WriteString(`<span class="` + s.pg.o.CSSClasses.Page + ` pg-skeleton">&nbsp;</span> `)
+	}
+	b.WriteString(`</nav>`)
+	return b.String()
+}
+
+// HTMLAria prints pagination as HTML with ARIA roles for a list style pager.
+// The current page is marked with aria-current.
+func (s *Set) HTMLAria(uri string) string {
+	var (
+		cl    = s.pg.o.CSSClasses
+		items []string
+	)
+	if s.PinFirstPage {
+		items = append(items,
+			`<li role="listitem"><a class="`+cl.First+`" href="`+s.href(uri, 1)+`">1</a></li>`,
+			`<li role="listitem" aria-hidden="true"><span class="`+cl.EllipsisFirst+`">...</span></li>`)
+	}
+	for i, p := range s.Pages {
+		if s.gapBefore(i) {
+			items = append(items, `<li role="listitem" aria-hidden="true"><span class="`+cl.Ellipsis+`">...</span></li>`)
+		}
+
+		c, cur := "", ""
+		if s.Page == p {
+			c = " " + cl.Selected
+			cur = ` aria-current="page"`
+		}
+		items = append(items, `<li role="listitem"><a class="`+cl.Page+c+`" href="`+s.href(uri, p)+`"`+cur+`>`+fmt.Sprintf("%d", p)+`</a></li>`)
+	}
+	if s.PinLastPage {
+		items = append(items,
+			`<li role="listitem" aria-hidden="true"><span class="`+cl.EllipsisLast+`">...</span></li>`,
+			`<li role="listitem"><a class="`+cl.Last+`" href="`+s.href(uri, s.TotalPages)+`">`+fmt.Sprintf("%d", s.TotalPages)+`</a></li>`)
+	}
+
+	var b bytes.Buffer
+	dir := ""
+	if s.pg.o.RTL {
+		dir = ` dir="rtl"`
+	}
+	b.WriteString(`<div class="` + cl.Wrapper + `" role="navigation" aria-label="Pagination"` + dir + `>`)
+	b.WriteString(`<ul role="list">`)
+	for _, it := range items {
+		b.WriteString(it)
+	}
+	b.WriteString(`</ul></div>`)
+	return b.String()
+}
+
+// HTMLWithJump prints a back to top link to topAnchor (e.g "#top"), the
+// pagination as HTML and a form to jump to a page number. The form submits
+// the page number in the PageParam query parameter to the path of uri
+// formatted with the current page. The other query params of uri are kept as
+// hidden inputs, e.g q in "/search?q=foo&page=%d".
+func (s *Set) HTMLWithJump(uri, topAnchor string) string {
+	var (
+		b        bytes.Buffer
+		param    = s.pg.o.PageParam
+		action   = s.pageURL(uri, s.Page)
+		attrLast = ""
+	)
+	if param == "" {
+		param = "page"
+	}
+	var hidden []string
+	if i := strings.IndexByte(action, '?'); i >= 0 {
+		query, _ := url.ParseQuery(action[i+1:])
+		for _, k := range slices.Sorted(maps.Keys(query)) {
+			if k == param {
+				continue
+			}
+			for _, v := range query[k] {
+				hidden = append(hidden, `<input type="hidden" name="`+html.EscapeString(k)+`" value="`+html.EscapeString(v)+`">`)
+			}
+		}
+		action = action[:i]
+	}
+	if s.TotalPages > 0 {
+		attrLast = ` max="` + strconv.Itoa(s.paramPage(s.TotalPages)) + `"`
+	}
+
+	b.WriteString(`<a class="pg-top" href="` + html.EscapeString(topAnchor) + `">Top</a> `)
+	b.WriteString(s.HTML(uri))
+	b.WriteString(`<form class="pg-jump" method="get" action="` + html.EscapeString(action) + `">`)
+	b.WriteString(strings.Join(hidden, ""))
+	b.WriteString(`<input class="pg-jump-input" type="number" name="` + param + `" min="` + strconv.Itoa(s.paramPage(1)) + `"` + attrLast + ` value="` + strconv.Itoa(s.paramPage(s.Page)) + `">`)
+	b.WriteString(`<button class="pg-jump-submit" type="submit">Go</button>`)
+	b.WriteString(`</form>`)
+	return b.String()
+}
+
+// HTMLNumberedPrevNext prints pagination as HTML with previous and next links
+// that show the number of the page they lead to, e.g "‹ 2" and "4 ›". The
+// links are omitted on the first and last pages respectively.
+func (s *Set) HTMLNumberedPrevNext(uri string) string {
+	var (
+		cl         = s.pg.o.CSSClasses
+		prev, next string
+	)
+	if s.Page > 1 {
+		prev = `<a class="` + cl.Prev + `" rel="prev" href="` + s.href(uri, s.Page-1) + `">` + fmt.Sprintf("‹ %d", s.Page-1) + `</a> `
+	}
+	if s.Page < s.lastPage() {
+		next = `<a class="` + cl.Next + `" rel="next" href="` + s.href(uri, s.Page+1) + `">` + fmt.Sprintf("%d ›", s.Page+1) + `</a> `
+	}
+	return prev + s.HTML(uri) + next
+}
+
+// HTMLDropdownGaps prints pagination as HTML where the pages hidden between
+// the first page, the page number series and the last page, and in gaps of
+// the series, are listed in <details> dropdowns instead of being replaced by
+// an ellipsis.
+func (s *Set) HTMLDropdownGaps(uri string) string {
+	if len(s.Pages) == 0 {
+		return ""
+	}
+
+	var (
+		cl    = s.pg.o.CSSClasses
+		first = s.Pages[0]
+		last  = s.Pages[len(s.Pages)-1]
+		items []string
+	)
+
+	gap := func(class string, from, to int) string {
+		var b bytes.Buffer
+		b.WriteString(`<details class="` + class + `"><summary>...</summary>`)
+		for p := from; p <= to; p++ {
+			b.WriteString(`<a class="` + cl.Page + `" href="` + s.href(uri, p) + `">` + fmt.Sprintf("%d", p) + `</a>`)
+		}
+		b.WriteString(`</details>`)
+		return b.String()
+	}
+
+	if first > 1 {
+		items = append(items, `<a class="`+cl.First+`" href="`+s.href(uri, 1)+`">1</a>`)
+		if first > 2 {
+			items = append(items, gap("pg-page-dropdown-first", 2, first-1))
+		}
+	}
+	for i, p := range s.Pages {
+		if s.gapBefore(i) {
+			items = append(items, gap("pg-page-dropdown", s.Pages[i-1]+1, p-1))
+		}
+
+		c, cur := "", ""
+		if s.Page == p {
+			c = " " + cl.Selected
+			cur = ` aria-current="page"`
+		}
+		items = append(items, `<a class="`+cl.Page+c+`" href="`+s.href(uri, p)+`"`+cur+`>`+fmt.Sprintf("%d", p)+`</a>`)
+	}
+	if last < s.TotalPages {
+		if last < s.TotalPages-1 {
+			items = append(items, gap("pg-page-dropdown-last", last+1, s.TotalPages-1))
+		}
+		items = append(items, `<a class="`+cl.Last+`" href="`+s.href(uri, s.TotalPages)+`">`+fmt.Sprintf("%d", s.TotalPages)+`</a>`)
+	}
+
+	return s.join(items)
+}
+
+// HTMLEndpoints prints compact pagination as HTML that only shows the first,
+// current and last pages with ellipses between them, e.g (1, ..., 457, ..., 9182).
+// An ellipsis is left out when the pages on either side of it are adjacent.
+func (s *Set) HTMLEndpoints(uri string) string {
+	var (
+		cl    = s.pg.o.CSSClasses
+		last  = s.lastPage()
+		items []string
+	)
+
+	page := func(p int) string {
+		c, cur := "", ""
+		if s.Page == p {
+			c = " " + cl.Selected
+			cur = ` aria-current="page"`
+		}
+		return `<a class="` + cl.Page + c + `" href="` + s.href(uri, p) + `"` + cur + `>` + fmt.Sprintf("%d", p) + `</a>`
+	}
+
+	items = append(items, page(1))
+	if s.Page > 2 {
+		items = append(items, `<span class="`+cl.EllipsisFirst+`" aria-hidden="true">...</span>`)
+	}
+	if s.Page > 1 && s.Page < last {
+		items = append(items, page(s.Page))
+	}
+	if s.Page < last-1 {
+		items = append(items, `<span class="`+cl.EllipsisLast+`" aria-hidden="true">...</span>`)
+	}
+	if last > 1 {
+		items = append(items, page(last))
+	}
+	return s.join(items)
+}
+
+// HTMLBootstrap prints pagination as HTML with the markup of Bootstrap 5's
+// pagination component, with previous and next arrows. The current page is
+// marked active and the arrows are disabled on the first and last pages.
+func (s *Set) HTMLBootstrap(uri string) string {
+	var (
+		last  = s.lastPage()
+		items []string
+	)
+
+	link := func(p int, text, class, attrs string) string {
+		return `<li class="page-item` + class + `"><a class="page-link" href="` + s.href(uri, p) + `"` + attrs + `>` + text + `</a></li>`
+	}
+	disabled := func(text, attrs string) string {
+		return `<li class="page-item disabled"><span class="page-link"` + attrs + `>` + text + `</span></li>`
+	}
+
+	if s.Page > 1 {
+		items = append(items, link(s.Page-1, "&laquo;", "", ` rel="prev" aria-label="Previous"`))
+	} else {
+		items = append(items, disabled("&laquo;", ` aria-label="Previous"`))
+	}
+	if s.PinFirstPage {
+		items = append(items, link(1, "1", "", ""), disabled("&hellip;", ` aria-hidden="true"`))
+	}
+	for i, p := range s.Pages {
+		if s.gapBefore(i) {
+			items = append(items, disabled("&hellip;", ` aria-hidden="true"`))
+		}
+		if s.Page == p {
+			items = append(items, link(p, strconv.Itoa(p), " active", ` aria-current="page"`))
+			continue
+		}
+		items = append(items, link(p, strconv.Itoa(p), "", ""))
+	}
+	if s.PinLastPage {
+		items = append(items, disabled("&hellip;", ` aria-hidden="true"`), link(s.TotalPages, strconv.Itoa(s.TotalPages), "", ""))
+	}
+	if s.Page < last {
+		items = append(items, link(s.Page+1, "&raquo;", "", ` rel="next" aria-label="Next"`))
+	} else {
+		items = append(items, disabled("&raquo;", ` aria-label="Next"`))
+	}
+
+	var b bytes.Buffer
+	dir := ""
+	if s.pg.o.RTL {
+		dir = ` dir="rtl"`
+	}
+	b.WriteString(`<nav aria-label="Pagination"` + dir + `><ul class="pagination">`)
+	for _, it := range items {
+		b.WriteString(it)
+	}
+	b.WriteString(`</ul></nav>`)
+	return b.String()
+}
+
+// HTMLTailwind prints pagination as minimal HTML for utility class frameworks
+// such as Tailwind CSS, with the classes of each element taken from classes
+// by slot: "container" for the <nav>, "link" for page links, "active" for the
+// current page (added to "link") and "ellipsis" for the gaps. Missing slots
+// are printed without a class.
+func (s *Set) HTMLTailwind(uri string, classes map[string]string) string {
+	var items []string
+	class := func(c string) string {
+		if c == "" {
+			return ""
+		}
+		return ` class="` + html.EscapeString(c) + `"`
+	}
+	link := func(p int) string {
+		c, cur := classes["link"], ""
+		if s.Page == p {
+			c = strings.TrimSpace(c + " " + classes["active"])
+			cur = ` aria-current="page"`
+		}
+		return `<a` + class(c) + ` href="` + s.href(uri, p) + `"` + cur + `>` + strconv.Itoa(p) + `</a>`
+	}
+	ellipsis := `<span` + class(classes["ellipsis"]) + ` aria-hidden="true">&hellip;</span>`
+
+	if s.PinFirstPage {
+		items = append(items, link(1), ellipsis)
+	}
+	for i, p := range s.Pages {
+		if s.gapBefore(i) {
+			items = append(items, ellipsis)
+		}
+		items = append(items, link(p))
+	}
+	if s.PinLastPage {
+		items = append(items, ellipsis, link(s.TotalPages))
+	}
+
+	var b bytes.Buffer
+	dir := ""
+	if s.pg.o.RTL {
+		dir = ` dir="rtl"`
+	}
+	b.WriteString(`<nav` + class(classes["container"]) + ` aria-label="Pagination"` + dir + `>`)
+	for _, it := range items {
+		b.WriteString(it)
+	}
+	b.WriteString(`</nav>`)
+	return b.String()
+}
@@ -0,0 +1,264 @@
+package paginator
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestHTMLWithJump(t *testing.T) {
+	p := New(Default())
+	s := p.New(2, 10)
+	s.SetTotal(100)
+
+	out := s.HTMLWithJump("/search?q=foo&tag=a&tag=b&page=%d", "#top")
+	for _, want := range []string{
+		`action="/search"`,
+		`<input type="hidden" name="q" value="foo">`,
+		`<input type="hidden" name="tag" value="a"><input type="hidden" name="tag" value="b">`,
+		`name="page" min="1" max="10" value="2"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("HTMLWithJump() = %s, missing %s", out, want)
+		}
+	}
+	if strings.Contains(out, `type="hidden" name="page"`) {
+		t.Errorf("HTMLWithJump() = %s, page param kept as hidden input", out)
+	}
+
+	out = s.HTMLWithJump(`/"><b>?page=%d`, `#"><b>`)
+	if strings.Contains(out, `"><b>`) {
+		t.Errorf("HTMLWithJump() = %s, want the action and anchor escaped", out)
+	}
+}
+
+func TestHTMLSkeleton(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 3
+	s := New(o).New(1, 10)
+
+	out := s.HTMLSkeleton()
+	if want := `<nav class="pg-pages" aria-label="Pagination" aria-busy="true" aria-hidden="true">`; !strings.HasPrefix(out, want) || !strings.HasSuffix(out, `</nav>`) {
+		t.Errorf("HTMLSkeleton() = %s, want it wrapped in %s", out, want)
+	}
+	if n := strings.Count(out, "pg-skeleton"); n != 3 {
+		t.Errorf("HTMLSkeleton() has %d items, want 3", n)
+	}
+}
+
+func TestSVGProgress(t *testing.T) {
+	tests := []struct {
+		page, total int
+		want        string
+	}{
+		{5, 200, `<rect class="pg-progress-fill" width="50" height="4"`},
+		{20, 200, `<rect class="pg-progress-fill" width="200" height="4"`},
+		{1, 0, `<rect class="pg-progress-fill" width="0" height="4"`},
+	}
+	for _, tc := range tests {
+		s := BuildSet(tc.page, 10, tc.total, Default())
+		if out := s.SVGProgress(200, 4); !strings.Contains(out, tc.want) {
+			t.Errorf("page %d of total %d: SVGProgress() = %s, missing %s", tc.page, tc.total, out, tc.want)
+		}
+	}
+}
+
+func TestSelectedAsSpan(t *testing.T) {
+	tests := []struct {
+		asSpan bool
+		want   string
+	}{
+		{false, `<a class="pg-page pg-selected" href="/p?page=2" aria-current="page">2</a>`},
+		{true, `<span class="pg-page pg-selected" aria-current="page">2</span>`},
+	}
+	for _, tc := range tests {
+		o := Default()
+		o.SelectedAsSpan = tc.asSpan
+		s := BuildSet(2, 10, 50, o)
+		out := s.HTML("/p?page=%d")
+		if !strings.Contains(out, tc.want) {
+			t.Errorf("SelectedAsSpan %t: HTML() = %s, missing %s", tc.asSpan, out, tc.want)
+		}
+		if !strings.Contains(out, `<a class="pg-page" href="/p?page=3" rel="next">3</a>`) {
+			t.Errorf("SelectedAsSpan %t: HTML() = %s, other pages should be links", tc.asSpan, out)
+		}
+	}
+}
+
+func TestHTMLAria(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 3
+	s := BuildSet(5, 10, 100, o)
+
+	out := s.HTMLAria("/p?page=%d")
+	for _, want := range []string{
+		`<div class="pg-pages" role="navigation" aria-label="Pagination"><ul role="list">`,
+		`<a class="pg-page pg-selected" href="/p?page=5" aria-current="page">5</a>`,
+		`<li role="listitem" aria-hidden="true"><span class="pg-page-ellipsis-first">...</span></li>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("HTMLAria() = %s, missing %s", out, want)
+		}
+	}
+	if n := strings.Count(out, "aria-current"); n != 1 {
+		t.Errorf("HTMLAria() has aria-current %d times, want once", n)
+	}
+	if out := s.HTMLAria(`/p?q="><b>&page=%d`); strings.Contains(out, `"><b>`) {
+		t.Errorf("HTMLAria() = %s, want the hrefs escaped", out)
+	}
+	if li, items := strings.Count(out, "<li "), strings.Count(out, `role="listitem"`); li != items || li != 7 {
+		t.Errorf("HTMLAria() has %d items with %d listitem roles, want 7", li, items)
+	}
+}
+
+func TestRTL(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 3
+
+	tests := []struct {
+		rtl     bool
+		wantDir bool
+	}{
+		{false, false},
+		{true, true},
+	}
+	for _, tc := range tests {
+		o.RTL = tc.rtl
+		s := BuildSet(2, 10, 50, o)
+		out := s.HTML("/p?page=%d")
+
+		if got := strings.Contains(out, `<nav class="pg-pages" aria-label="Pagination" dir="rtl">`); got != tc.wantDir {
+			t.Errorf("RTL %t: HTML() = %s, has dir=rtl %t, want %t", tc.rtl, out, got, tc.wantDir)
+		}
+
+		first, last := strings.Index(out, `href="/p?page=1"`), strings.Index(out, `href="/p?page=5"`)
+		if first < 0 || last < 0 || first > last {
+			t.Errorf("RTL %t: HTML() = %s, page 1 at %d and page 5 at %d, want logical order", tc.rtl, out, first, last)
+		}
+
+		out = s.HTMLNumberedPrevNext("/p?page=%d")
+		if prev, next := strings.Index(out, `rel="prev"`), strings.Index(out, `rel="next"`); prev < 0 || prev > next {
+			t.Errorf("RTL %t: HTMLNumberedPrevNext() = %s, want prev before next", tc.rtl, out)
+		}
+	}
+}
+
+func TestHTMLNumberedPrevNext(t *testing.T) {
+	tests := []struct {
+		page       int
+		prev, next string
+	}{
+		{3, `<a class="pg-prev" rel="prev" href="/p?page=2">‹ 2</a>`, `<a class="pg-next" rel="next" href="/p?page=4">4 ›</a>`},
+		{1, "", `<a class="pg-next" rel="next" href="/p?page=2">2 ›</a>`},
+		{5, `<a class="pg-prev" rel="prev" href="/p?page=4">‹ 4</a>`, ""},
+	}
+	for _, tc := range tests {
+		s := BuildSet(tc.page, 10, 50, Default())
+		out := s.HTMLNumberedPrevNext("/p?page=%d")
+		if tc.prev != "" && !strings.HasPrefix(out, tc.prev) || tc.prev == "" && strings.Contains(out, "pg-prev") {
+			t.Errorf("page %d: HTMLNumberedPrevNext() = %s, want prev %q", tc.page, out, tc.prev)
+		}
+		if tc.next != "" && !strings.HasSuffix(out, tc.next+" ") || tc.next == "" && strings.Contains(out, "pg-next") {
+			t.Errorf("page %d: HTMLNumberedPrevNext() = %s, want next %q", tc.page, out, tc.next)
+		}
+	}
+}
+
+func TestHTMLDropdownGaps(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 3
+	s := BuildSet(8, 10, 200, o)
+
+	out := s.HTMLDropdownGaps("/p?page=%d")
+	dropdowns := map[string][]string{}
+	for _, d := range strings.Split(out, "<details")[1:] {
+		class := d[strings.Index(d, `"`)+1 : strings.Index(d, `">`)]
+		d = d[:strings.Index(d, "</details>")]
+		for _, a := range strings.Split(d, `href="/p?page=`)[1:] {
+			dropdowns[class] = append(dropdowns[class], a[:strings.IndexByte(a, '"')])
+		}
+	}
+
+	want := map[string][]string{
+		"pg-page-dropdown-first": {"2", "3", "4", "5", "6"},
+		"pg-page-dropdown-last":  {"10", "11", "12", "13", "14", "15", "16", "17", "18", "19"},
+	}
+	if len(dropdowns) != len(want) {
+		t.Fatalf("HTMLDropdownGaps() = %s, dropdowns %v, want %v", out, dropdowns, want)
+	}
+	for class, pages := range want {
+		if !slices.Equal(dropdowns[class], pages) {
+			t.Errorf("HTMLDropdownGaps() %s = %v, want %v", class, dropdowns[class], pages)
+		}
+	}
+}
+
+// endpointItem matches the items of HTMLEndpoints, with the aria-current
+// attribute of the current page and the text.
+var endpointItem = regexp.MustCompile(`<(?:a|span) [^>]*?( aria-current="page")?>([^<]*)</`)
+
+func TestHTMLEndpoints(t *testing.T) {
+	tests := []struct {
+		page int
+		want []string
+	}{
+		{457, []string{"1", "...", "457*", "...", "919"}},
+		{2, []string{"1", "2*", "...", "919"}},
+		{918, []string{"1", "...", "918*", "919"}},
+		{1, []string{"1*", "...", "919"}},
+	}
+	for _, tc := range tests {
+		s := BuildSet(tc.page, 10, 9182, Default())
+		out := s.HTMLEndpoints("/p?page=%d")
+
+		var got []string
+		for _, m := range endpointItem.FindAllStringSubmatch(out, -1) {
+			if m[1] != "" {
+				m[2] += "*"
+			}
+			got = append(got, m[2])
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("page %d: HTMLEndpoints() = %s, items %v, want %v", tc.page, out, got, tc.want)
+		}
+	}
+}
+
+func TestHTMLBootstrap(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 3
+	s := BuildSet(1, 10, 50, o)
+
+	out := s.HTMLBootstrap("/p?q=a&b&page=%d")
+	for _, want := range []string{
+		`<li class="page-item disabled"><span class="page-link" aria-label="Previous">&laquo;</span></li>`,
+		`<li class="page-item active"><a class="page-link" href="/p?q=a&amp;b&amp;page=1" aria-current="page">1</a></li>`,
+		`<a class="page-link" href="/p?q=a&amp;b&amp;page=2" rel="next" aria-label="Next">&raquo;</a>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("HTMLBootstrap() = %s, missing %s", out, want)
+		}
+	}
+	if out := s.HTMLBootstrap(`/p?q="><b>&page=%d`); strings.Contains(out, `"><b>`) {
+		t.Errorf("HTMLBootstrap() = %s, want the hrefs escaped", out)
+	}
+}
+
+func TestHTMLEscapesURLs(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 3
+	s := BuildSet(5, 10, 100, o)
+
+	uri := `/p?q="><b>&page=%d`
+	for name, out := range map[string]string{
+		"HTMLTailwind":         s.HTMLTailwind(uri, map[string]string{"link": "px-2"}),
+		"HTMLDropdownGaps":     s.HTMLDropdownGaps(uri),
+		"HTMLEndpoints":        s.HTMLEndpoints(uri),
+		"HTMLNumberedPrevNext": s.HTMLNumberedPrevNext(uri),
+	} {
+		if !strings.Contains(out, `href="/p?q=&#34;&gt;&lt;b&gt;&amp;page=`) || strings.Contains(out, `"><b>`) {
+			t.Errorf("%s() = %s, want the hrefs escaped", name, out)
+		}
+	}
+}
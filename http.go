@@ -0,0 +1,117 @@
+package paginator
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ctxKey is the context key for the set stored by Middleware.
+type ctxKey struct{}
+
+// NewFromRequest returns a new paginator set from the query params of an
+// HTTP request. If Option.ReadForm is set, the params are read from the
+// request's form, which includes url-encoded POST bodies.
+func (p *Paginator) NewFromRequest(r *http.Request) Set {
+	return p.NewFromUrl(p.requestValues(r))
+}
+
+// NewFromRequestStrict is like NewFromRequest but returns an error for
+// invalid params like NewFromUrlStrict.
+func (p *Paginator) NewFromRequestStrict(r *http.Request) (Set, error) {
+	return p.NewFromUrlStrict(p.requestValues(r))
+}
+
+// requestValues returns the values of the request to read params from.
+func (p *Paginator) requestValues(r *http.Request) url.Values {
+	if p.o.ReadForm {
+		if err := r.ParseForm(); err == nil {
+			return r.Form
+		}
+	}
+	return r.URL.Query()
+}
+
+// Middleware returns net/http middleware that parses the pagination params of
+// every request with NewFromRequest and stores the set in the request's
+// context, to be retrieved with FromContext. With Option.Parse set to
+// ParseStrict, requests with invalid params are parsed with
+// NewFromRequestStrict and rejected with 400 Bad Request.
+func Middleware(p *Paginator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if p.o.Parse != ParseStrict {
+				next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), p.NewFromRequest(r))))
+				return
+			}
+
+			s, err := p.NewFromRequestStrict(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), s)))
+		})
+	}
+}
+
+// NewContext returns a copy of ctx that holds the set.
+func NewContext(ctx context.Context, s Set) context.Context {
+	return context.WithValue(ctx, ctxKey{}, s)
+}
+
+// FromContext returns the set stored in ctx by Middleware. ok is false if
+// there is none.
+func FromContext(ctx context.Context) (s Set, ok bool) {
+	s, ok = ctx.Value(ctxKey{}).(Set)
+	return s, ok
+}
+
+// WriteHeaders sets the total, total pages, page and per page response
+// headers, named by Option.Headers, for header based clients such as
+// react-admin. Call it after SetTotal and before writing the body.
+func (s *Set) WriteHeaders(w http.ResponseWriter) {
+	var (
+		h = w.Header()
+		n = s.pg.o.Headers
+	)
+	h.Set(n.Total, strconv.Itoa(s.Total))
+	h.Set(n.TotalPages, strconv.Itoa(s.TotalPages))
+	h.Set(n.Page, strconv.Itoa(s.Page))
+	h.Set(n.PerPage, strconv.Itoa(s.PerPage))
+}
+
+// WriteJSON writes a 200 OK JSON response for r with items in a standard
+// envelope, {"data": [...], "pagination": {...}}, where the pagination is the
+// Meta of the set with URLs based on the request URL, so that filters and the
+// per page param are kept. Call it after SetTotal.
+func (s *Set) WriteJSON(w http.ResponseWriter, r *http.Request, items interface{}) error {
+	meta := s.meta(r.URL)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	return json.NewEncoder(w).Encode(struct {
+		Data       interface{} `json:"data"`
+		Pagination Meta        `json:"pagination"`
+	}{items, meta})
+}
+
+// WriteXML is like WriteJSON for XML clients. The envelope is
+// <response><data><item>...</item></data><pagination>...</pagination></response>
+// with the same pagination values as the JSON one.
+func (s *Set) WriteXML(w http.ResponseWriter, r *http.Request, items interface{}) error {
+	meta := s.meta(r.URL)
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	return xml.NewEncoder(w).Encode(struct {
+		XMLName    xml.Name    `xml:"response"`
+		Data       interface{} `xml:"data>item"`
+		Pagination Meta        `xml:"pagination"`
+	}{Data: items, Pagination: meta})
+}
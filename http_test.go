@@ -0,0 +1,105 @@
+package paginator
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWriteJSON(t *testing.T) {
+	var (
+		p = New(Default())
+		r = httptest.NewRequest("GET", "/things?q=foo&per_page=25&page=2", nil)
+		w = httptest.NewRecorder()
+		s = p.NewFromRequest(r)
+	)
+	s.SetTotal(100)
+	if err := s.WriteJSON(w, r, []int{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+
+	var body struct {
+		Data       []int `json:"data"`
+		Pagination Meta  `json:"pagination"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if want := "/things?page=3&per_page=25&q=foo"; body.Pagination.NextURL != want {
+		t.Errorf("next url = %q, want %q", body.Pagination.NextURL, want)
+	}
+	if want := "/things?page=1&per_page=25&q=foo"; body.Pagination.PrevURL != want {
+		t.Errorf("prev url = %q, want %q", body.Pagination.PrevURL, want)
+	}
+	if len(body.Data) != 2 || !body.Pagination.HasNext || body.Pagination.From != 26 {
+		t.Errorf("body = %+v", body)
+	}
+}
+
+func TestWriteXML(t *testing.T) {
+	var (
+		p = New(Default())
+		r = httptest.NewRequest("GET", "/things?q=foo&page=2", nil)
+		w = httptest.NewRecorder()
+		s = p.NewFromRequest(r)
+	)
+	s.SetTotal(30)
+	if err := s.WriteXML(w, r, []string{"a"}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := xml.Header + `<response><data><item>a</item></data><pagination><page>2</page><per_page>10</per_page>` +
+		`<total_pages>3</total_pages><total>30</total><from>11</from><to>20</to><has_prev>true</has_prev>` +
+		`<has_next>true</has_next><prev_url>/things?page=1&amp;q=foo</prev_url><next_url>/things?page=3&amp;q=foo</next_url>` +
+		`</pagination></response>`
+	if got := w.Body.String(); got != want {
+		t.Errorf("body = %s\nwant %s", got, want)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	strict := Default()
+	strict.Parse = ParseStrict
+
+	tests := []struct {
+		name     string
+		o        Option
+		target   string
+		wantCode int
+		page     int
+		perPage  int
+	}{
+		{"valid", Default(), "/?page=3&per_page=20", http.StatusOK, 3, 20},
+		{"fallback", Default(), "/?page=abc&per_page=20", http.StatusOK, 1, 20},
+		{"strict valid", strict, "/?page=3", http.StatusOK, 3, 10},
+		{"strict invalid", strict, "/?page=abc", http.StatusBadRequest, 0, 0},
+	}
+	for _, tc := range tests {
+		var (
+			got Set
+			ok  bool
+		)
+		h := Middleware(New(tc.o))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, ok = FromContext(r.Context())
+		}))
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", tc.target, nil))
+		if w.Code != tc.wantCode {
+			t.Errorf("%s: status = %d, want %d", tc.name, w.Code, tc.wantCode)
+		}
+		if tc.wantCode == http.StatusOK && (!ok || got.Page != tc.page || got.PerPage != tc.perPage) {
+			t.Errorf("%s: FromContext() = page %d, per page %d, %t, want %d, %d, true", tc.name, got.Page, got.PerPage, ok, tc.page, tc.perPage)
+		}
+	}
+
+	if _, ok := FromContext(httptest.NewRequest("GET", "/", nil).Context()); ok {
+		t.Error("FromContext() without Middleware is ok")
+	}
+}
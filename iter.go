@@ -0,0 +1,32 @@
+package paginator
+
+import "iter"
+
+// Pages returns an iterator over the sets of every page of total items with
+// perPage items per page, with the Offset and Limit of each page computed,
+// e.g for batch jobs:
+//
+//	for s := range p.Pages(total, 500) {
+//		rows := fetch(s.Offset, s.Limit)
+//	}
+//
+// There are no sets if total is 0. Iteration stops at Option.MaxPage and
+// Option.MaxOffset.
+func (p *Paginator) Pages(total, perPage int) iter.Seq[Set] {
+	return func(yield func(Set) bool) {
+		if total <= 0 {
+			return
+		}
+
+		for page := 1; ; page++ {
+			s := p.New(page, perPage)
+			if s.Page != page {
+				return
+			}
+			s.SetTotal(total)
+			if page > s.TotalPages || !yield(s) {
+				return
+			}
+		}
+	}
+}
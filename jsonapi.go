@@ -0,0 +1,79 @@
+package paginator
+
+import (
+	"net/url"
+)
+
+// Query params of JSON:API's page based pagination strategy.
+const (
+	jsonAPIPageParam    = "page[number]"
+	jsonAPIPerPageParam = "page[size]"
+)
+
+// JSONAPIPagination holds the pagination links and meta of a JSON:API
+// document, to be embedded in the top level document.
+type JSONAPIPagination struct {
+	Links JSONAPILinks `json:"links"`
+	Meta  JSONAPIMeta  `json:"meta"`
+}
+
+// JSONAPILinks are JSON:API pagination links. Prev and Next are null when
+// there is no such page, as the spec requires.
+type JSONAPILinks struct {
+	Self  string  `json:"self"`
+	First string  `json:"first"`
+	Prev  *string `json:"prev"`
+	Next  *string `json:"next"`
+	Last  string  `json:"last"`
+}
+
+// JSONAPIMeta is the pagination meta of a JSON:API document.
+type JSONAPIMeta struct {
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
+
+// NewFromJSONAPI returns a new paginator set from JSON:API's page[number]
+// and page[size] query params.
+func (p *Paginator) NewFromJSONAPI(q url.Values) Set {
+	var (
+		page, _    = p.atoi(q.Get(jsonAPIPageParam))
+		perPage, _ = p.atoi(q.Get(jsonAPIPerPageParam))
+	)
+	return p.New(page, perPage)
+}
+
+// JSONAPI returns the JSON:API pagination links for the set's page, with
+// page[number] and page[size] set on baseURL, and the total in meta.
+// Call it after SetTotal. It returns an error if baseURL can't be parsed.
+func (s *Set) JSONAPI(baseURL string) (JSONAPIPagination, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return JSONAPIPagination{}, err
+	}
+
+	var (
+		last = s.lastPage()
+		link = func(page int) string {
+			return withParams(u, jsonAPIPageParam, page, jsonAPIPerPageParam, s.requestedPerPage())
+		}
+		out = JSONAPIPagination{
+			Links: JSONAPILinks{
+				Self:  link(s.Page),
+				First: link(1),
+				Last:  link(last),
+			},
+			Meta: JSONAPIMeta{Total: s.Total, TotalPages: s.TotalPages},
+		}
+	)
+
+	if s.Page > 1 {
+		l := link(s.Page - 1)
+		out.Links.Prev = &l
+	}
+	if s.Page < last {
+		l := link(s.Page + 1)
+		out.Links.Next = &l
+	}
+	return out, nil
+}
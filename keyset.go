@@ -0,0 +1,133 @@
+package paginator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// KeysetColumn is a column of the sort order of a keyset query.
+type KeysetColumn struct {
+	Name string
+	Desc bool
+}
+
+// Asc returns a keyset column sorted in ascending order.
+func Asc(name string) KeysetColumn {
+	return KeysetColumn{Name: name}
+}
+
+// Desc returns a keyset column sorted in descending order.
+func Desc(name string) KeysetColumn {
+	return KeysetColumn{Name: name, Desc: true}
+}
+
+// Keyset generates the SQL for keyset (seek) pagination, which filters on the
+// sort keys of the last seen item instead of using OFFSET, so that deep pages
+// are as cheap to fetch as the first one. The keys come from a Cursor.
+// The columns must uniquely identify a row, e.g by ending with the primary key.
+type Keyset struct {
+	Columns []KeysetColumn
+
+	// Placeholder returns the bind parameter placeholder for the nth (1-based)
+	// argument. If it is nil, "?" is used. Use Dollar for PostgreSQL.
+	Placeholder func(n int) string
+}
+
+// NewKeyset returns a keyset for the given ordered columns,
+// e.g NewKeyset(Desc("created_at"), Desc("id")).
+func NewKeyset(cols ...KeysetColumn) *Keyset {
+	return &Keyset{Columns: cols}
+}
+
+// Dollar returns PostgreSQL style placeholders ($1, $2 ...).
+func Dollar(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+// Where returns the condition and bind args that select the rows after the
+// cursor, e.g "(created_at, id) < (?, ?)". For a before cursor, the rows
+// before the cursor are selected. An empty condition is returned on the
+// first page. If the columns are sorted in different directions, the
+// condition is expanded as row value comparison only works for one direction.
+func (k *Keyset) Where(c Cursor) (string, []interface{}, error) {
+	if c.IsFirst() {
+		return "", nil, nil
+	}
+	if len(c.Keys) != len(k.Columns) {
+		return "", nil, fmt.Errorf("%w: keys do not match keyset columns", ErrInvalidCursor)
+	}
+
+	var (
+		n    = 0
+		args []interface{}
+	)
+	ph := func(v interface{}) string {
+		n++
+		args = append(args, v)
+		if k.Placeholder == nil {
+			return "?"
+		}
+		return k.Placeholder(n)
+	}
+
+	if k.sameDirection() {
+		cols := make([]string, len(k.Columns))
+		phs := make([]string, len(k.Columns))
+		for i, col := range k.Columns {
+			cols[i] = col.Name
+			phs[i] = ph(c.Keys[i])
+		}
+
+		if len(cols) == 1 {
+			return cols[0] + " " + k.op(k.Columns[0], c.Before) + " " + phs[0], args, nil
+		}
+		return "(" + strings.Join(cols, ", ") + ") " + k.op(k.Columns[0], c.Before) + " (" + strings.Join(phs, ", ") + ")", args, nil
+	}
+
+	// (a > ?) OR (a = ? AND b < ?) OR ...
+	ors := make([]string, 0, len(k.Columns))
+	for i, col := range k.Columns {
+		ands := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, k.Columns[j].Name+" = "+ph(c.Keys[j]))
+		}
+		ands = append(ands, col.Name+" "+k.op(col, c.Before)+" "+ph(c.Keys[i]))
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+	}
+	return "(" + strings.Join(ors, " OR ") + ")", args, nil
+}
+
+// OrderBy returns the ORDER BY expression for the cursor, e.g
+// "created_at DESC, id DESC". For a before cursor the order is reversed, and
+// the fetched rows have to be reversed back into the normal order.
+func (k *Keyset) OrderBy(c Cursor) string {
+	cols := make([]string, len(k.Columns))
+	for i, col := range k.Columns {
+		dir := "ASC"
+		if col.Desc != c.Before {
+			dir = "DESC"
+		}
+		cols[i] = col.Name + " " + dir
+	}
+	return strings.Join(cols, ", ")
+}
+
+// op returns the comparison operator that selects the rows after (or before)
+// the cursor on the column.
+func (k *Keyset) op(col KeysetColumn, before bool) string {
+	if col.Desc != before {
+		return "<"
+	}
+	return ">"
+}
+
+// sameDirection reports whether all the columns are sorted in the same direction.
+func (k *Keyset) sameDirection() bool {
+	for _, col := range k.Columns {
+		if col.Desc != k.Columns[0].Desc {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package paginator
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestKeysetWhere(t *testing.T) {
+	var (
+		after  = Cursor{Keys: []interface{}{"t", int64(5)}}
+		before = Cursor{Keys: []interface{}{"t", int64(5)}, Before: true}
+	)
+	dollar := NewKeyset(Desc("created_at"), Desc("id"))
+	dollar.Placeholder = Dollar
+
+	tests := []struct {
+		name    string
+		k       *Keyset
+		c       Cursor
+		where   string
+		args    []interface{}
+		orderBy string
+	}{
+		{"first page", NewKeyset(Desc("created_at"), Desc("id")), Cursor{}, "", nil, "created_at DESC, id DESC"},
+		{"same direction", NewKeyset(Desc("created_at"), Desc("id")), after,
+			"(created_at, id) < (?, ?)", []interface{}{"t", int64(5)}, "created_at DESC, id DESC"},
+		{"same direction before", NewKeyset(Desc("created_at"), Desc("id")), before,
+			"(created_at, id) > (?, ?)", []interface{}{"t", int64(5)}, "created_at ASC, id ASC"},
+		{"single column", NewKeyset(Asc("id")), Cursor{Keys: []interface{}{int64(5)}},
+			"id > ?", []interface{}{int64(5)}, "id ASC"},
+		{"mixed directions", NewKeyset(Desc("created_at"), Asc("id")), after,
+			"((created_at < ?) OR (created_at = ? AND id > ?))", []interface{}{"t", "t", int64(5)}, "created_at DESC, id ASC"},
+		{"mixed directions before", NewKeyset(Desc("created_at"), Asc("id")), before,
+			"((created_at > ?) OR (created_at = ? AND id < ?))", []interface{}{"t", "t", int64(5)}, "created_at ASC, id DESC"},
+		{"dollar placeholders", dollar, after,
+			"(created_at, id) < ($1, $2)", []interface{}{"t", int64(5)}, "created_at DESC, id DESC"},
+	}
+	for _, tc := range tests {
+		where, args, err := tc.k.Where(tc.c)
+		if err != nil || where != tc.where || !slices.Equal(args, tc.args) {
+			t.Errorf("%s: Where() = %q, %v, %v, want %q, %v", tc.name, where, args, err, tc.where, tc.args)
+		}
+		if got := tc.k.OrderBy(tc.c); got != tc.orderBy {
+			t.Errorf("%s: OrderBy() = %q, want %q", tc.name, got, tc.orderBy)
+		}
+	}
+}
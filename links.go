@@ -0,0 +1,144 @@
+package paginator
+
+import (
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// LinkHeader returns an RFC 8288 Link header value with the first, prev,
+// next and last page URLs, e.g <https://api.example.com/things?page=3>; rel="next".
+// The URLs are baseURL with the page (and per page, if it isn't the default)
+// query params set and its other query params kept. Links that do not apply
+// to the current page are omitted. It returns an empty string if baseURL
+// can't be parsed.
+func (s *Set) LinkHeader(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return ""
+	}
+
+	var (
+		last  = s.lastPage()
+		links []string
+	)
+	add := func(rel string, page int) {
+		links = append(links, "<"+s.linkURL(u, page)+`>; rel="`+rel+`"`)
+	}
+
+	add("first", 1)
+	if s.Page > 1 {
+		add("prev", s.Page-1)
+	}
+	if s.Page < last {
+		add("next", s.Page+1)
+	}
+	add("last", last)
+	return strings.Join(links, ", ")
+}
+
+// WriteLinkHeader sets the Link header of the response to LinkHeader(baseURL).
+func (s *Set) WriteLinkHeader(w http.ResponseWriter, baseURL string) {
+	if l := s.LinkHeader(baseURL); l != "" {
+		w.Header().Set("Link", l)
+	}
+}
+
+// PageURL returns a copy of base with only the page query param (named
+// Option.PageParam) set to page, keeping and encoding all its other query
+// params, e.g filters like ?q=foo&sort=name. Pass the request URL as base to
+// link to other pages of the same listing. With Option.LimitOffset, the
+// offset and limit params of the page are set instead.
+func (s *Set) PageURL(base *url.URL, page int) string {
+	if s.pg.o.LimitOffset {
+		return s.offsetURL(base, page)
+	}
+	return withParams(base, s.pg.o.PageParam, s.paramPage(page), "", 0)
+}
+
+// PrevURL returns PageURL for the previous page, or an empty string on the
+// first page.
+func (s *Set) PrevURL(base *url.URL) string {
+	if s.Page <= 1 {
+		return ""
+	}
+	return s.PageURL(base, s.Page-1)
+}
+
+// NextURL returns PageURL for the next page, or an empty string on the last
+// page. Call it after SetTotal.
+func (s *Set) NextURL(base *url.URL) string {
+	if s.Page >= s.lastPage() {
+		return ""
+	}
+	return s.PageURL(base, s.Page+1)
+}
+
+// linkURL returns u with the page query param set to page, and the per page
+// param set if it isn't the default, for links that don't depend on a
+// request URL that already has them. Other query params are kept. The per
+// page is the requested one, which the pages grow from with
+// Option.GrowthFactor.
+func (s *Set) linkURL(u *url.URL, page int) string {
+	if s.pg.o.LimitOffset {
+		return s.offsetURL(u, page)
+	}
+
+	perPage := 0
+	if n := s.requestedPerPage(); n != s.pg.o.DefaultPerPage {
+		perPage = n
+	}
+	return withParams(u, s.pg.o.PageParam, s.paramPage(page), s.pg.o.PerPageParam, perPage)
+}
+
+// offsetURL returns u with the offset and limit query params of
+// Option.LimitOffset set for page. Other query params are kept.
+func (s *Set) offsetURL(u *url.URL, page int) string {
+	return withParams(u, s.pg.o.OffsetParam, s.pageOffset(page), s.pg.o.LimitParam, s.requestedPerPage())
+}
+
+// pageOffset returns the offset of page for Option.LimitOffset links. Pages
+// are counted in steps of Limit from the set's Offset, which need not be a
+// multiple of it, so that following them neither repeats nor skips items.
+// With Option.GrowthFactor the steps are the sizes of the pages in between.
+// The first page starts at 0.
+func (s *Set) pageOffset(page int) int {
+	if page <= 1 {
+		return 0
+	}
+	if s.basePerPage > 0 {
+		d := s.pg.grownOffset(s.basePerPage, page) - s.pg.grownOffset(s.basePerPage, s.Page)
+		return max(s.Offset+d, 0)
+	}
+	return max(s.Offset+(page-s.Page)*s.Limit, 0)
+}
+
+// withParams returns u with the page (or offset) and, if it is greater than 0,
+// the per page query params set. Other query params are kept.
+func withParams(u *url.URL, pageParam string, page int, perPageParam string, perPage int) string {
+	c := *u
+	q := c.Query()
+	q.Set(pageParam, strconv.Itoa(page))
+	if perPage > 0 {
+		q.Set(perPageParam, strconv.Itoa(perPage))
+	}
+	c.RawQuery = q.Encode()
+	return c.String()
+}
+
+// HeadLinks returns <link rel="prev"> and <link rel="next"> tags with PrevURL
+// and NextURL for the <head> of a paginated page, for crawlers to find the
+// other pages. Tags for pages that don't exist are left out. Call it after
+// SetTotal.
+func (s *Set) HeadLinks(base *url.URL) string {
+	var b strings.Builder
+	if u := s.PrevURL(base); u != "" {
+		b.WriteString(`<link rel="prev" href="` + html.EscapeString(u) + `">`)
+	}
+	if u := s.NextURL(base); u != "" {
+		b.WriteString(`<link rel="next" href="` + html.EscapeString(u) + `">`)
+	}
+	return b.String()
+}
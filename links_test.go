@@ -0,0 +1,145 @@
+package paginator
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestLimitOffsetLinks(t *testing.T) {
+	o := Default()
+	o.LimitOffset = true
+	p := New(o)
+
+	s := p.NewFromUrl(url.Values{"limit": {"20"}, "offset": {"45"}})
+	s.SetTotal(200)
+	u, _ := url.Parse("/things?q=foo")
+
+	cases := []struct {
+		name, got, want string
+	}{
+		{"next", s.NextURL(u), "/things?limit=20&offset=65&q=foo"},
+		{"prev", s.PrevURL(u), "/things?limit=20&offset=25&q=foo"},
+		{"first", s.PageURL(u, 1), "/things?limit=20&offset=0&q=foo"},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("%s = %q, want %q", c.name, c.got, c.want)
+		}
+	}
+
+	if got := s.ParamDiff(s.Page + 1); len(got) != 1 || got["offset"] != "65" {
+		t.Errorf("ParamDiff = %v, want map[offset:65]", got)
+	}
+}
+
+func TestLinkHeader(t *testing.T) {
+	tests := []struct {
+		page, perPage int
+		want          string
+	}{
+		{3, 10, `<https://api.example.com/things?page=1&q=a%2Cb>; rel="first", ` +
+			`<https://api.example.com/things?page=2&q=a%2Cb>; rel="prev", ` +
+			`<https://api.example.com/things?page=4&q=a%2Cb>; rel="next", ` +
+			`<https://api.example.com/things?page=10&q=a%2Cb>; rel="last"`},
+		{1, 25, `<https://api.example.com/things?page=1&per_page=25&q=a%2Cb>; rel="first", ` +
+			`<https://api.example.com/things?page=2&per_page=25&q=a%2Cb>; rel="next", ` +
+			`<https://api.example.com/things?page=4&per_page=25&q=a%2Cb>; rel="last"`},
+	}
+	for _, tc := range tests {
+		s := BuildSet(tc.page, tc.perPage, 100, Default())
+		if got := s.LinkHeader("https://api.example.com/things?q=a,b&page=7"); got != tc.want {
+			t.Errorf("page %d of %d: LinkHeader() =\n%s\nwant\n%s", tc.page, tc.perPage, got, tc.want)
+		}
+	}
+
+	s := BuildSet(1, 10, 100, Default())
+	if got := s.LinkHeader("%zz"); got != "" {
+		t.Errorf("LinkHeader() of an invalid URL = %q, want none", got)
+	}
+}
+
+func TestZeroIndexed(t *testing.T) {
+	o := Default()
+	o.ZeroIndexed = true
+	p := New(o)
+
+	tests := []struct {
+		param    string
+		wantPage int
+	}{
+		{"0", 1},
+		{"2", 3},
+		{"-1", 1},
+		{"", 1},
+	}
+	for _, tc := range tests {
+		if s := p.NewFromUrl(url.Values{"page": {tc.param}}); s.Page != tc.wantPage {
+			t.Errorf("page=%s: Page = %d, want %d", tc.param, s.Page, tc.wantPage)
+		}
+	}
+
+	s := p.NewFromUrl(url.Values{"page": {"2"}})
+	s.SetTotal(100)
+	u, _ := url.Parse("/things")
+	cases := []struct {
+		name, got, want string
+	}{
+		{"next", s.NextURL(u), "/things?page=3"},
+		{"prev", s.PrevURL(u), "/things?page=1"},
+		{"first", s.PageURL(u, 1), "/things?page=0"},
+		{"html", s.pageURL("/things?page=%d", s.Page), "/things?page=2"},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("%s = %q, want %q", c.name, c.got, c.want)
+		}
+	}
+
+	if _, err := p.NewFromUrlStrict(url.Values{"page": {"-1"}}); !errors.Is(err, ErrInvalidPage) {
+		t.Errorf("NewFromUrlStrict(page=-1) err = %v, want %v", err, ErrInvalidPage)
+	}
+}
+
+func TestGrowthLinks(t *testing.T) {
+	for _, limitOffset := range []bool{false, true} {
+		o := Default()
+		o.GrowthFactor = 2
+		o.LimitOffset = limitOffset
+		p := New(o)
+
+		q := url.Values{"per_page": {"5"}, "limit": {"5"}}
+		end, pages := 0, 0
+		for q != nil {
+			s := p.NewFromUrl(q)
+			s.SetTotal(500)
+			if s.Offset != end {
+				t.Fatalf("limit offset %v: page %d offset = %d, want %d", limitOffset, s.Page, s.Offset, end)
+			}
+			end, pages = s.Offset+s.Limit, pages+1
+
+			next := s.NextURL(&url.URL{Path: "/things", RawQuery: q.Encode()})
+			q = nil
+			if next != "" {
+				u, _ := url.Parse(next)
+				q = u.Query()
+			}
+		}
+		if end < 500 || pages != 13 {
+			t.Errorf("limit offset %v: %d pages up to %d, want 13 pages up to 500", limitOffset, pages, end)
+		}
+	}
+
+	o := Default()
+	o.GrowthFactor = 2
+	s := New(o).New(2, 5)
+	s.SetTotal(500)
+	if got, want := s.LinkHeader("/things"), `</things?page=3&per_page=5>; rel="next"`; !strings.Contains(got, want) {
+		t.Errorf("LinkHeader() = %q, want it to contain %q", got, want)
+	}
+	want := "/things?page%5Bnumber%5D=3&page%5Bsize%5D=5"
+	if got, _ := s.JSONAPI("/things"); got.Links.Next == nil || *got.Links.Next != want {
+		t.Errorf("JSONAPI() next = %v, want %q", got.Links.Next, want)
+	}
+}
@@ -0,0 +1,21 @@
+module github.com/purisaurabh/paginator/mongopaginator
+
+go 1.23
+
+require (
+	github.com/purisaurabh/paginator v0.1.0
+	go.mongodb.org/mongo-driver v1.15.1
+)
+
+require (
+	github.com/golang/snappy v0.0.1 // indirect
+	github.com/klauspost/compress v1.17.0 // indirect
+	github.com/montanaflynn/stats v0.0.0-20171201202039-1bf9dbcd8cbe // indirect
+	github.com/xdg-go/pbkdf2 v1.0.0 // indirect
+	github.com/xdg-go/scram v1.1.2 // indirect
+	github.com/xdg-go/stringprep v1.0.4 // indirect
+	github.com/youmark/pkcs8 v0.0.0-20181117223130-1be2e3e5546d // indirect
+	golang.org/x/crypto v0.23.0 // indirect
+	golang.org/x/sync v0.1.0 // indirect
+	golang.org/x/text v0.15.0 // indirect
+)
@@ -0,0 +1,84 @@
+// Package mongopaginator integrates paginator with the MongoDB Go driver.
+package mongopaginator
+
+import (
+	"context"
+
+	"github.com/purisaurabh/paginator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FindOptions returns the find options with the Skip and Limit of the set's
+// page and the given sort order, e.g bson.D{{Key: "name", Value: 1}}.
+// The sort order should be unique, e.g by ending with _id, for pages to be
+// stable. MongoDB has no limit of 0, so skip the query of an Empty set
+// instead, which would find every document.
+func FindOptions(s paginator.Set, sort interface{}) *options.FindOptions {
+	o := options.Find().SetSkip(int64(s.Offset))
+	if s.Limit > 0 {
+		o.SetLimit(int64(s.Limit))
+	}
+	if sort != nil {
+		o.SetSort(sort)
+	}
+	return o
+}
+
+// CursorFilter returns the filter that selects the documents after the
+// cursor by _id, i.e {"_id": {"$gt": id}}, or before it for a before cursor.
+// The first key of the cursor is the _id of the last seen document.
+// An empty filter is returned on the first page. Combine it with other
+// conditions using $and.
+func CursorFilter(c paginator.Cursor) bson.M {
+	if c.IsFirst() {
+		return bson.M{}
+	}
+
+	op := "$gt"
+	if c.Before {
+		op = "$lt"
+	}
+	return bson.M{"_id": bson.M{op: objectID(c.Keys[0])}}
+}
+
+// CursorFindOptions returns the find options that sort by _id and limit the
+// documents to the cursor's page. For a before cursor the sort order is
+// reversed, and the fetched documents have to be reversed back.
+func CursorFindOptions(c paginator.Cursor) *options.FindOptions {
+	dir := 1
+	if c.Before {
+		dir = -1
+	}
+
+	o := options.Find().SetSort(bson.D{{Key: "_id", Value: dir}})
+	if c.PerPage > 0 {
+		o.SetLimit(int64(c.PerPage))
+	}
+	return o
+}
+
+// Count counts the documents in coll matching filter with CountDocuments and
+// sets the result as the total of the set.
+func Count(ctx context.Context, coll *mongo.Collection, filter interface{}, s *paginator.Set) error {
+	n, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	s.SetTotal(int(n))
+	return nil
+}
+
+// objectID converts a cursor key back into an ObjectID, as ObjectIDs are
+// encoded in cursors as hex strings. Other keys are returned as is.
+func objectID(k interface{}) interface{} {
+	if h, ok := k.(string); ok {
+		if id, err := primitive.ObjectIDFromHex(h); err == nil {
+			return id
+		}
+	}
+	return k
+}
@@ -0,0 +1,65 @@
+package paginator
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// NewFromOData returns a new paginator set from OData's $top and $skip query
+// params, which map onto the Limit and Offset. $top is clamped to MaxPerPage.
+// $count=true requests the total count, see ODataCountRequested.
+// Errors wrap ErrInvalidPage or ErrInvalidPerPage.
+func (p *Paginator) NewFromOData(q url.Values) (Set, error) {
+	top, skip := 0, 0
+	if v := q.Get("$top"); v != "" {
+		n, err := p.atoi(v)
+		if err != nil || n < 0 {
+			return Set{}, fmt.Errorf("%w: $top %q", ErrInvalidPerPage, v)
+		}
+		top = n
+	}
+	if v := q.Get("$skip"); v != "" {
+		n, err := p.atoi(v)
+		if err != nil || n < 0 {
+			return Set{}, fmt.Errorf("%w: $skip %q", ErrInvalidPage, v)
+		}
+		skip = n
+	}
+
+	s := p.newFromOffset(skip, top)
+	s.odataCount = q.Get("$count") == "true"
+	return s, nil
+}
+
+// ODataCountRequested reports whether the total count was requested with
+// $count=true, in which case the total has to be queried and set.
+func (s *Set) ODataCountRequested() bool {
+	return s.odataCount
+}
+
+// OData returns the OData control information for the set's page to merge
+// into the response: @odata.count, if it was requested, and @odata.nextLink,
+// which is baseURL with $skip and $top for the next page, if there is one.
+// Call it after SetTotal. It returns an error if baseURL can't be parsed.
+func (s *Set) OData(baseURL string) (map[string]interface{}, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]interface{}{}
+	if s.odataCount {
+		out["@odata.count"] = s.Total
+	}
+
+	if next := s.Offset + s.Limit; s.Limit > 0 && next < s.Total {
+		q := u.Query()
+		q.Set("$skip", strconv.Itoa(next))
+		q.Set("$top", strconv.Itoa(s.Limit))
+		c := *u
+		c.RawQuery = q.Encode()
+		out["@odata.nextLink"] = c.String()
+	}
+	return out, nil
+}
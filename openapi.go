@@ -0,0 +1,99 @@
+package paginator
+
+import "strconv"
+
+// OpenAPIParam is an OpenAPI 3 parameter object. It is JSON encoded in the
+// shape of the spec, so it can be decoded into the parameter types of
+// libraries such as kin-openapi or swag.
+type OpenAPIParam struct {
+	Name        string        `json:"name"`
+	In          string        `json:"in"`
+	Description string        `json:"description,omitempty"`
+	Required    bool          `json:"required,omitempty"`
+	Schema      OpenAPISchema `json:"schema"`
+}
+
+// OpenAPISchema is an OpenAPI 3 schema object for the pagination params and
+// metadata.
+type OpenAPISchema struct {
+	Type       string                   `json:"type"`
+	Format     string                   `json:"format,omitempty"`
+	Minimum    *int                     `json:"minimum,omitempty"`
+	Maximum    *int                     `json:"maximum,omitempty"`
+	Default    interface{}              `json:"default,omitempty"`
+	Enum       []int                    `json:"enum,omitempty"`
+	Properties map[string]OpenAPISchema `json:"properties,omitempty"`
+}
+
+// OpenAPIParams returns the definitions of the query params NewFromUrl reads,
+// with the names, defaults and limits of the paginator's options, for
+// generating API docs that match the configuration.
+func (p *Paginator) OpenAPIParams() []OpenAPIParam {
+	perPage := OpenAPISchema{
+		Type:    "integer",
+		Minimum: intPtr(1),
+		Default: p.o.DefaultPerPage,
+		Enum:    p.o.PerPageOptions,
+	}
+	perPageDesc := "Number of items per page."
+	if p.o.AllowAll {
+		perPageDesc += " " + strconv.Quote(p.o.AllowAllParam) + " returns all items."
+		perPage = OpenAPISchema{Type: "string", Default: strconv.Itoa(p.o.DefaultPerPage)}
+	} else {
+		perPage.Maximum = intPtr(p.o.MaxPerPage)
+	}
+
+	if p.o.LimitOffset {
+		offset := OpenAPISchema{Type: "integer", Minimum: intPtr(0), Default: 0}
+		if p.o.MaxOffset > 0 {
+			offset.Maximum = intPtr(p.o.MaxOffset)
+		}
+		return []OpenAPIParam{
+			{Name: p.o.LimitParam, In: "query", Description: perPageDesc, Schema: perPage},
+			{Name: p.o.OffsetParam, In: "query", Description: "Number of items to skip.", Schema: offset},
+		}
+	}
+
+	first := 1
+	if p.o.ZeroIndexed {
+		first = 0
+	}
+	page := OpenAPISchema{Type: "integer", Minimum: intPtr(first), Default: first}
+	if p.o.MaxPage > 0 {
+		page.Maximum = intPtr(p.o.MaxPage - 1 + first)
+	}
+	return []OpenAPIParam{
+		{Name: p.o.PageParam, In: "query", Description: "Page number.", Schema: page},
+		{Name: p.o.PerPageParam, In: "query", Description: perPageDesc, Schema: perPage},
+	}
+}
+
+// OpenAPIMetaSchema returns the schema of the pagination metadata returned by
+// Set.Meta and written by Set.WriteJSON.
+func (p *Paginator) OpenAPIMetaSchema() OpenAPISchema {
+	var (
+		integer = OpenAPISchema{Type: "integer"}
+		boolean = OpenAPISchema{Type: "boolean"}
+		uri     = OpenAPISchema{Type: "string", Format: "uri-reference"}
+	)
+	return OpenAPISchema{
+		Type: "object",
+		Properties: map[string]OpenAPISchema{
+			"page":        integer,
+			"per_page":    integer,
+			"total_pages": integer,
+			"total":       integer,
+			"from":        integer,
+			"to":          integer,
+			"has_prev":    boolean,
+			"has_next":    boolean,
+			"prev_url":    uri,
+			"next_url":    uri,
+		},
+	}
+}
+
+// intPtr returns a pointer to n.
+func intPtr(n int) *int {
+	return &n
+}
@@ -0,0 +1,126 @@
+package paginator
+
+import "html/template"
+
+// OptionFunc sets a value of an Option, for NewWithOptions.
+type OptionFunc func(*Option)
+
+// NewWithOptions returns a new paginator instance with the Default options
+// changed by opts, e.g
+//
+//	p := NewWithOptions(WithDefaultPerPage(20), WithParamNames("p", "size"))
+//
+// It is a shorthand for changing the options returned by Default and passing
+// them to New.
+func NewWithOptions(opts ...OptionFunc) *Paginator {
+	o := Default()
+	for _, fn := range opts {
+		fn(&o)
+	}
+	return New(o)
+}
+
+// WithDefaultPerPage sets Option.DefaultPerPage.
+func WithDefaultPerPage(n int) OptionFunc {
+	return func(o *Option) {
+		o.DefaultPerPage = n
+	}
+}
+
+// WithMaxPerPage sets Option.MaxPerPage.
+func WithMaxPerPage(n int) OptionFunc {
+	return func(o *Option) {
+		o.MaxPerPage = n
+	}
+}
+
+// WithNumPageNums sets Option.NumPageNums.
+func WithNumPageNums(n int) OptionFunc {
+	return func(o *Option) {
+		o.NumPageNums = n
+	}
+}
+
+// WithParamNames sets Option.PageParam and Option.PerPageParam.
+func WithParamNames(page, perPage string) OptionFunc {
+	return func(o *Option) {
+		o.PageParam, o.PerPageParam = page, perPage
+	}
+}
+
+// WithAllowAll sets Option.AllowAll, with param as Option.AllowAllParam.
+func WithAllowAll(param string) OptionFunc {
+	return func(o *Option) {
+		o.AllowAll, o.AllowAllParam = true, param
+	}
+}
+
+// WithPerPageOptions sets Option.PerPageOptions.
+func WithPerPageOptions(sizes ...int) OptionFunc {
+	return func(o *Option) {
+		o.PerPageOptions = sizes
+	}
+}
+
+// WithMaxPage sets Option.MaxPage.
+func WithMaxPage(n int) OptionFunc {
+	return func(o *Option) {
+		o.MaxPage = n
+	}
+}
+
+// WithZeroIndexed sets Option.ZeroIndexed.
+func WithZeroIndexed() OptionFunc {
+	return func(o *Option) {
+		o.ZeroIndexed = true
+	}
+}
+
+// WithLimitOffset sets Option.LimitOffset, with limit and offset as
+// Option.LimitParam and Option.OffsetParam.
+func WithLimitOffset(limit, offset string) OptionFunc {
+	return func(o *Option) {
+		o.LimitOffset, o.LimitParam, o.OffsetParam = true, limit, offset
+	}
+}
+
+// WithWindow sets Option.Window.
+func WithWindow(w WindowStrategy) OptionFunc {
+	return func(o *Option) {
+		o.Window = w
+	}
+}
+
+// WithTemplate sets Option.Template.
+func WithTemplate(t *template.Template) OptionFunc {
+	return func(o *Option) {
+		o.Template = t
+	}
+}
+
+// WithCSSClasses sets Option.CSSClasses.
+func WithCSSClasses(c CSSClasses) OptionFunc {
+	return func(o *Option) {
+		o.CSSClasses = c
+	}
+}
+
+// WithLabels sets Option.Labels.
+func WithLabels(l Labels) OptionFunc {
+	return func(o *Option) {
+		o.Labels = l
+	}
+}
+
+// NewWith returns a new paginator set like New, with the options of the
+// paginator changed by opts for this set only, e.g to allow a higher
+// MaxPerPage on one endpoint without constructing a second paginator:
+//
+//	s := p.NewWith(page, perPage, WithMaxPerPage(500))
+func (p *Paginator) NewWith(page, perPage int, opts ...OptionFunc) Set {
+	o := p.o
+	for _, fn := range opts {
+		fn(&o)
+	}
+	return New(o).New(page, perPage)
+}
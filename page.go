@@ -0,0 +1,46 @@
+package paginator
+
+// Page is a response envelope holding the items of a page along with the
+// pagination values of its set, which are embedded in the JSON output, e.g
+// {"items": [...], "page": 2, "per_page": 10, "total_pages": 5, "total": 42}.
+type Page[T any] struct {
+	Items []T `json:"items" xml:"items>item"`
+	Set
+}
+
+// WrapItems returns a page envelope with the items of the set's page.
+// A nil slice is wrapped as an empty one so that it is encoded as [] in JSON.
+// It is a function and not a method of Set as methods can't have type parameters.
+func WrapItems[T any](s Set, items []T) Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return Page[T]{Items: items, Set: s}
+}
+
+// PaginateSlice returns the items of the set's page from an already loaded
+// slice, using the set's Offset and Limit. The returned slice shares its
+// backing array with items. It is empty if the set is Empty.
+func PaginateSlice[T any](items []T, set Set) []T {
+	if set.Empty {
+		return items[:0]
+	}
+
+	start := set.Offset
+	if start > len(items) {
+		start = len(items)
+	}
+
+	end := start + set.Limit
+	if set.Limit == 0 || end > len(items) {
+		end = len(items)
+	}
+	return items[start:end]
+}
+
+// PaginateSliceTotal sets the total of the set to the length of items and
+// returns the items of the set's page like PaginateSlice.
+func PaginateSliceTotal[T any](items []T, set *Set) []T {
+	set.SetTotal(len(items))
+	return PaginateSlice(items, *set)
+}
@@ -0,0 +1,1388 @@
+// Package paginator provides a simple abstraction for handling pagination
+// requests and offset/limit generation for HTTP requests, and for generating
+// HTML-ready page number series.
+package paginator
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type Option struct {
+	// DefaultPerPage is the default number of items per page.
+	DefaultPerPage int
+
+	// MaxPerPage is the maximum number of items per page.
+	// If a client requests more than this number, it will be reduced to this number.
+	MaxPerPage int
+
+	// MaxPage is the highest page that can be requested, to protect the
+	// database from deep OFFSET scans. Higher pages are reduced to it, or
+	// rejected by NewFromUrlStrict.
+	MaxPage int
+
+	// MaxOffset is the highest offset that can be requested, like MaxPage
+	// but independent of the number of items per page.
+	MaxOffset int
+
+	// PerPageOptions are the only numbers of items per page that are allowed,
+	// e.g 10, 25, 50 and 100. Other values are snapped to the nearest allowed
+	// one, or the smaller one of two equally near. It is exposed with
+	// Set.PerPageOptions for rendering a page size selector.
+	PerPageOptions []int
+
+	// MaxNumPageNums is the maximum number of page numbers to show in the pagination.
+	// e.g if numpagenums is 5, and current page is 10, the pagination will show (1 , 2 ,3 , 4 , 5, ... , 10)
+	NumPageNums int
+
+	// InnerWindow and OuterWindow show InnerWindow page numbers on either side
+	// of the current page and OuterWindow page numbers at each end of the
+	// pagination, with ellipses between them, instead of NumPageNums page
+	// numbers. e.g with an InnerWindow of 2 and an OuterWindow of 1, page 10
+	// of 20 shows (1, ..., 8, 9, 10, 11, 12, ..., 20). They are used if
+	// either is set and Window isn't.
+	InnerWindow int
+	OuterWindow int
+
+	// Pin chooses which of the first and last pages are pinned, with an
+	// ellipsis, when the page number series doesn't include them. The default,
+	// PinBoth, always shows both ends of the pagination.
+	Pin PinMode
+
+	// PageParam is the query parameter for the per page number.
+	PerPageParam string
+
+	// PageParam is the query parameter for the current page number.
+	PageParam string
+
+	// ZeroIndexed numbers the first page 0 instead of 1 in the page query
+	// param and in the page URLs of links and HTML, e.g for Spring Data style
+	// APIs. Set.Page and the printed page numbers stay 1-based, so that
+	// Offset and the other values are computed as usual.
+	ZeroIndexed bool
+
+	// Parse is how the numbers of query params are parsed, see ParseMode.
+	Parse ParseMode
+
+	// LimitOffset makes NewFromUrl read the number of items and the offset
+	// to start at from the LimitParam and OffsetParam query params, e.g
+	// ?limit=20&offset=40, instead of the page and per page. The Page is the
+	// one the offset falls on, and links set the limit and offset of pages.
+	LimitOffset bool
+
+	// LimitParam and OffsetParam are the query params for LimitOffset.
+	// They default to "limit" and "offset".
+	LimitParam  string
+	OffsetParam string
+
+	// AllowAll allows the client to request all items without pagination.
+	AllowAll bool
+
+	// AllowAllParam is the query parameter to request all items without pagination.
+	AllowAllParam string
+
+	// FullBelow shows every page number, without ellipsis, when the total
+	// number of pages is less than or equal to this number.
+	// e.g if FullBelow is 12 and there are 12 pages, all 12 page numbers are shown
+	// regardless of NumPageNums.
+	FullBelow int
+
+	// FrozenTotalTolerance is the maximum difference allowed between a total
+	// echoed back by a client and the actual total in ValidateFrozenTotal.
+	FrozenTotalTolerance int
+
+	// SelectedAsSpan renders the current page as a <span> without a link
+	// instead of an <a> in HTML.
+	SelectedAsSpan bool
+
+	// PinnedPrefix is the number of pinned items, fetched separately, that are
+	// shown at the top of the first page before the paginated results.
+	// The first page's Limit is reduced by PinnedPrefix to make room for them
+	// and the Offset of every following page is shifted back by PinnedPrefix
+	// so that no results are skipped.
+	// e.g with 3 pinned items and 10 per page, page 1 is offset 0, limit 7 and
+	// page 2 is offset 7, limit 10.
+	PinnedPrefix int
+
+	// MinGapForEllipsis is the minimum number of hidden pages between a pinned
+	// first or last page and the page number series for an ellipsis to be shown.
+	// Smaller gaps are filled with their page numbers instead.
+	// e.g if MinGapForEllipsis is 2, the pagination shows (1, 2, 3, 4, 5) instead of (1, ..., 3, 4, 5).
+	MinGapForEllipsis int
+
+	// RTL sets dir="rtl" on the container of the pagination for right-to-left
+	// languages. The items stay in logical order and the browser lays them
+	// out right to left.
+	RTL bool
+
+	// CursorParam is the query parameter for the keyset cursor of the page.
+	CursorParam string
+
+	// CursorSecret is the secret to sign cursor tokens with using HMAC-SHA256.
+	// If set, the keys of decoded cursors can be trusted as tampered or forged
+	// tokens are rejected.
+	CursorSecret []byte
+
+	// BeforeParam is the query parameter for a keyset cursor to page backwards
+	// from, i.e to get the page before the cursor.
+	BeforeParam string
+
+	// VisibleLimit is the maximum number of items that can be paginated through,
+	// e.g for free tier users. Past this many items the set is Restricted while
+	// Total still holds the actual total for display.
+	VisibleLimit int
+
+	// FeaturedFirstCount is the number of featured items, queried from a
+	// separate source, that make up the first page. When set, page 1 has
+	// offset 0 and limit FeaturedFirstCount against the featured source and
+	// pages from 2 onwards are offset into the normal source by
+	// (page-2)*PerPage. The total is the total of the normal source and the
+	// featured page is counted in TotalPages. ItemRange numbers the featured
+	// items before the items of the normal source.
+	FeaturedFirstCount int
+
+	// GrowthFactor grows the number of items per page with every page, to make
+	// deep pages cheaper to reach. When greater than 1, page n has
+	// perPage*GrowthFactor^(n-1) items (rounded down) capped at MaxPerPage,
+	// and its Offset is the sum of the number of items on pages 1 to n-1.
+	// e.g with 10 per page and a GrowthFactor of 2, pages 1, 2 and 3 have
+	// 10, 20 and 40 items at offsets 0, 10 and 30.
+	// It requires MaxPerPage to be set.
+	GrowthFactor float64
+
+	// ReadForm makes NewFromRequest read the params from the parsed form,
+	// which includes url-encoded POST bodies, instead of only the URL query.
+	ReadForm bool
+
+	// Headers are the names of the response headers written by WriteHeaders.
+	Headers HeaderNames
+
+	// RangeUnit is the unit of Range and Content-Range headers, e.g "items".
+	RangeUnit string
+
+	// Template is the template HTML prints pagination with, instead of
+	// DefaultTemplate. It is executed with TemplateData.
+	Template *template.Template
+
+	// CSSClasses are the class names of the elements of HTML pagination.
+	CSSClasses CSSClasses
+
+	// Window is the strategy that chooses the page numbers shown in the page
+	// number series, e.g EdgesWindow. The default is a CenteredWindow of
+	// NumPageNums pages.
+	Window WindowStrategy
+
+	// Labels are the labels of the first, previous, next and last buttons
+	// that HTML prints around the page numbers. Buttons without a label are
+	// not printed, which is the default.
+	Labels Labels
+}
+
+// Labels are the labels of the first, previous, next and last buttons of
+// HTML pagination, e.g "«", "Précédent", "Suivant" and "»".
+type Labels struct {
+	First string
+	Prev  string
+	Next  string
+	Last  string
+}
+
+// ParseMode is how the numbers of pagination query params are parsed.
+type ParseMode int
+
+const (
+	// ParseFallback, the default, parses whole numbers and falls back to the
+	// defaults for invalid values, e.g per_page=10abc, which NewFromUrlStrict
+	// rejects.
+	ParseFallback ParseMode = iota
+
+	// ParseLenient trims values and parses their leading digits, e.g "25 "
+	// and "10abc" as 25 and 10.
+	ParseLenient
+
+	// ParseStrict parses whole numbers like ParseFallback, and rejects
+	// invalid values with an error instead of falling back to the defaults:
+	// Middleware (and chipaginator's) respond with 400 Bad Request to
+	// requests with invalid params, as rejected by NewFromRequestStrict, and
+	// NewFromCursor and NewFromStripe return an error for an invalid per
+	// page. Parsers that return no error, such as NewFromUrl and
+	// NewFromJSONAPI, can't reject values and fall back like ParseFallback,
+	// so use NewFromUrlStrict instead of them.
+	ParseStrict
+)
+
+// PinMode chooses which of the first and last pages of a pagination are
+// pinned.
+type PinMode int
+
+const (
+	// PinBoth pins the first and the last page.
+	PinBoth PinMode = iota
+
+	// PinFirst only pins the first page.
+	PinFirst
+
+	// PinLast only pins the last page.
+	PinLast
+
+	// PinNone pins neither, only the page number series is shown.
+	PinNone
+)
+
+// HeaderNames are the names of the pagination response headers.
+// Empty names are set to the defaults (X-Total-Count, X-Total-Pages, X-Page
+// and X-Per-Page) by New.
+type HeaderNames struct {
+	Total      string
+	TotalPages string
+	Page       string
+	PerPage    string
+}
+
+// CSSClasses are the class names of the elements of HTML pagination.
+// Empty names are set to the defaults (pg-pages, pg-page, pg-selected,
+// pg-page-first, pg-page-last, pg-page-ellipsis-first, pg-page-ellipsis-last,
+// pg-page-ellipsis, pg-prev, pg-next, pg-first and pg-last) by New.
+type CSSClasses struct {
+	Wrapper       string
+	Page          string
+	Selected      string
+	First         string
+	Last          string
+	EllipsisFirst string
+	EllipsisLast  string
+	Ellipsis      string
+	Prev          string
+	Next          string
+	FirstButton   string
+	LastButton    string
+}
+
+// Paginator represents a paginator instance.
+type Paginator struct {
+	o Option
+}
+
+// Set represents pagination values for the query
+type Set struct {
+	// These value are json and xml tagged in case they need to be embedded
+	// in a struct that's sent to the outside world.
+	Page       int `json:"page" xml:"page"`
+	PerPage    int `json:"per_page" xml:"per_page"`
+	TotalPages int `json:"total_pages" xml:"total_pages"`
+	Total      int `json:"total" xml:"total"`
+
+	// Computed values for queries.
+	Offset int `json:"-" xml:"-"`
+	Limit  int `json:"-" xml:"-"`
+
+	// Fields for rendering page numbers. Gaps in Pages are rendered as
+	// ellipses.
+	PinFirstPage bool  `json:"-" xml:"-"`
+	PinLastPage  bool  `json:"-" xml:"-"`
+	Pages        []int `json:"-" xml:"-"`
+	pg           *Paginator
+
+	// Pages to navigate to, set by SetTotal. PrevPage and NextPage are 0
+	// on the first and last pages.
+	FirstPage int `json:"first_page,omitempty" xml:"first_page,omitempty"`
+	PrevPage  int `json:"prev_page,omitempty" xml:"prev_page,omitempty"`
+	NextPage  int `json:"next_page,omitempty" xml:"next_page,omitempty"`
+	LastPage  int `json:"last_page,omitempty" xml:"last_page,omitempty"`
+
+	// Restricted is set when the total exceeds Option.VisibleLimit and only
+	// the first VisibleLimit items can be paginated through.
+	Restricted bool `json:"-" xml:"-"`
+
+	// Empty is set when the page has no items of its own to query, i.e the
+	// first page when Option.PinnedPrefix fills it. Its Limit is 0, which
+	// otherwise means no limit, so the query for the page should be skipped.
+	Empty bool `json:"-" xml:"-"`
+
+	// Grid dimensions for sets created with NewGrid.
+	gridRows, gridCols int
+
+	// Ring buffer head and capacity for sets created with NewRing.
+	ringHead, ringCap int
+
+	// Time span and bucket size for sets created with NewTimeBucket.
+	timeStart, timeEnd, bucketSeconds int64
+
+	// Sort values of the last hit of the previous page for search_after queries.
+	searchAfter []interface{}
+
+	// Whether the total count was requested with OData's $count.
+	odataCount bool
+
+	// Keyset cursor the set was created from and whether it is a cursor
+	// to page backwards from.
+	cursor string
+	before bool
+
+	// Number of items on the first page when Option.GrowthFactor is set.
+	basePerPage int
+}
+
+// Default returns a paginator.Opt with default values set.
+func Default() Option {
+	return Option{
+		DefaultPerPage: 10,
+		MaxPerPage:     50,
+		NumPageNums:    10,
+		PageParam:      "page",
+		PerPageParam:   "per_page",
+		AllowAll:       false,
+		AllowAllParam:  "all",
+
+		MinGapForEllipsis: 1,
+		CursorParam:       "cursor",
+		BeforeParam:       "before",
+	}
+}
+
+// GoogleAPI returns a paginator.Opt with default values set and the params of
+// Google API style cursor pagination, where clients send pageToken and
+// maxResults to NewFromCursor, and get the nextPageToken from
+// Cursor.NextPageToken.
+func GoogleAPI() Option {
+	o := Default()
+	o.CursorParam = "pageToken"
+	o.PerPageParam = "maxResults"
+	return o
+}
+
+// NewStrict is like New but returns an error wrapping ErrInvalidOption
+// instead of normalizing the option if it is invalid, see Option.Validate.
+func NewStrict(o Option) (*Paginator, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	return New(o), nil
+}
+
+// Validate returns an error wrapping ErrInvalidOption for each invalid value
+// of the option, e.g a DefaultPerPage below 1 or a MaxPerPage below the
+// DefaultPerPage, or nil if the option is valid. Empty param names are valid
+// as New sets them to the defaults.
+func (o Option) Validate() error {
+	var errs []error
+	invalid := func(format string, a ...interface{}) {
+		errs = append(errs, fmt.Errorf("%w: "+format, append([]interface{}{ErrInvalidOption}, a...)...))
+	}
+
+	if o.DefaultPerPage < 1 {
+		invalid("DefaultPerPage %d < 1", o.DefaultPerPage)
+	}
+	if !o.AllowAll && o.MaxPerPage < o.DefaultPerPage {
+		invalid("MaxPerPage %d < DefaultPerPage %d", o.MaxPerPage, o.DefaultPerPage)
+	}
+	if o.NumPageNums < 1 {
+		invalid("NumPageNums %d < 1", o.NumPageNums)
+	}
+	for _, f := range []struct {
+		name string
+		v    int
+	}{
+		{"FullBelow", o.FullBelow},
+		{"PinnedPrefix", o.PinnedPrefix},
+		{"VisibleLimit", o.VisibleLimit},
+		{"FeaturedFirstCount", o.FeaturedFirstCount},
+		{"MaxPage", o.MaxPage},
+		{"MaxOffset", o.MaxOffset},
+		{"InnerWindow", o.InnerWindow},
+		{"OuterWindow", o.OuterWindow},
+	} {
+		if f.v < 0 {
+			invalid("%s %d < 0", f.name, f.v)
+		}
+	}
+	for _, v := range o.PerPageOptions {
+		if v < 1 {
+			invalid("PerPageOptions value %d < 1", v)
+		}
+	}
+	if o.PageParam != "" && o.PageParam == o.PerPageParam {
+		invalid("PageParam and PerPageParam are both %q", o.PageParam)
+	}
+	return errors.Join(errs...)
+}
+
+// New returns a new paginator instance. Invalid values of the option are
+// normalized, e.g a DefaultPerPage below 1 is set to 10 and negative counts
+// to 0, use NewStrict to reject them instead.
+func New(o Option) *Paginator {
+	if o.DefaultPerPage < 1 {
+		o.DefaultPerPage = 10
+	}
+
+	if !o.AllowAll && o.MaxPerPage < o.DefaultPerPage {
+		o.MaxPerPage = o.DefaultPerPage
+	}
+
+	if o.NumPageNums < 1 {
+		o.NumPageNums = 10
+	}
+
+	for _, v := range []*int{&o.FullBelow, &o.PinnedPrefix, &o.VisibleLimit, &o.FeaturedFirstCount,
+		&o.MaxPage, &o.MaxOffset, &o.InnerWindow, &o.OuterWindow} {
+		*v = max(*v, 0)
+	}
+
+	o.PerPageOptions = slices.DeleteFunc(slices.Clone(o.PerPageOptions), func(v int) bool { return v < 1 })
+
+	if o.AllowAllParam == "" {
+		o.AllowAllParam = "all"
+	}
+
+	if o.PageParam == "" {
+		o.PageParam = "page"
+	}
+
+	if o.PerPageParam == "" {
+		o.PerPageParam = "per_page"
+	}
+
+	if o.LimitParam == "" {
+		o.LimitParam = "limit"
+	}
+
+	if o.OffsetParam == "" {
+		o.OffsetParam = "offset"
+	}
+
+	if o.CursorParam == "" {
+		o.CursorParam = "cursor"
+	}
+
+	if o.BeforeParam == "" {
+		o.BeforeParam = "before"
+	}
+
+	slices.Sort(o.PerPageOptions)
+
+	if o.MinGapForEllipsis < 1 {
+		o.MinGapForEllipsis = 1
+	}
+
+	if o.RangeUnit == "" {
+		o.RangeUnit = "items"
+	}
+
+	if o.Headers.Total == "" {
+		o.Headers.Total = "X-Total-Count"
+	}
+	if o.Headers.TotalPages == "" {
+		o.Headers.TotalPages = "X-Total-Pages"
+	}
+	if o.Headers.Page == "" {
+		o.Headers.Page = "X-Page"
+	}
+	if o.Headers.PerPage == "" {
+		o.Headers.PerPage = "X-Per-Page"
+	}
+
+	if o.CSSClasses.Wrapper == "" {
+		o.CSSClasses.Wrapper = "pg-pages"
+	}
+	if o.CSSClasses.Page == "" {
+		o.CSSClasses.Page = "pg-page"
+	}
+	if o.CSSClasses.Selected == "" {
+		o.CSSClasses.Selected = "pg-selected"
+	}
+	if o.CSSClasses.First == "" {
+		o.CSSClasses.First = "pg-page-first"
+	}
+	if o.CSSClasses.Last == "" {
+		o.CSSClasses.Last = "pg-page-last"
+	}
+	if o.CSSClasses.EllipsisFirst == "" {
+		o.CSSClasses.EllipsisFirst = "pg-page-ellipsis-first"
+	}
+	if o.CSSClasses.EllipsisLast == "" {
+		o.CSSClasses.EllipsisLast = "pg-page-ellipsis-last"
+	}
+	if o.CSSClasses.Ellipsis == "" {
+		o.CSSClasses.Ellipsis = "pg-page-ellipsis"
+	}
+	if o.CSSClasses.Prev == "" {
+		o.CSSClasses.Prev = "pg-prev"
+	}
+	if o.CSSClasses.Next == "" {
+		o.CSSClasses.Next = "pg-next"
+	}
+	if o.CSSClasses.FirstButton == "" {
+		o.CSSClasses.FirstButton = "pg-first"
+	}
+	if o.CSSClasses.LastButton == "" {
+		o.CSSClasses.LastButton = "pg-last"
+	}
+
+	return &Paginator{
+		o: o,
+	}
+}
+
+// Options returns the options of the paginator, with defaults filled in.
+func (p *Paginator) Options() Option {
+	return p.o
+}
+
+// BuildSet returns a fully populated set for the given page, per page and
+// total using a paginator created with the given options. It is meant for
+// building fixtures in tests without going through HTTP requests.
+func BuildSet(page, perPage, total int, o Option) Set {
+	s := New(o).New(page, perPage)
+	s.SetTotal(total)
+	return s
+}
+
+func (p *Paginator) NewFromUrl(q url.Values) Set {
+	var (
+		perPageParam = p.o.PerPageParam
+		pageParam    = p.o.PageParam
+	)
+	if p.o.LimitOffset {
+		perPageParam, pageParam = p.o.LimitParam, p.o.OffsetParam
+	}
+
+	var (
+		perPage, _ = p.atoi(q.Get(perPageParam))
+		page, err  = p.atoi(q.Get(pageParam))
+	)
+
+	if q.Get(perPageParam) == p.o.AllowAllParam {
+		perPage = -1
+	}
+
+	if p.o.LimitOffset {
+		return p.newFromUrlOffset(q, max(page, 0), perPage)
+	}
+	if err == nil {
+		page = p.pageFromParam(page)
+	}
+	return p.newFromUrl(q, page, perPage)
+}
+
+// NewFromUrlStrict is like NewFromUrl but returns an error instead of falling
+// back to defaults when the page or per page query params are invalid, so
+// that APIs can reject bad requests. The errors wrap ErrInvalidPage,
+// ErrInvalidPerPage, ErrPerPageExceedsMax, ErrPageExceedsMax or
+// ErrOffsetExceedsMax, or ErrInvalidOffset for Option.LimitOffset. Missing
+// params use the defaults.
+func (p *Paginator) NewFromUrlStrict(q url.Values) (Set, error) {
+	perPageParam := p.o.PerPageParam
+	if p.o.LimitOffset {
+		perPageParam = p.o.LimitParam
+	}
+
+	perPage := 0
+	if v := q.Get(perPageParam); v != "" {
+		if v == p.o.AllowAllParam && p.o.AllowAll {
+			perPage = -1
+		} else {
+			n, err := p.atoi(v)
+			if err != nil || n < 1 {
+				return Set{}, fmt.Errorf("%w: %q", ErrInvalidPerPage, v)
+			}
+			if !p.o.AllowAll && n > p.o.MaxPerPage {
+				return Set{}, fmt.Errorf("%w: %d > %d", ErrPerPageExceedsMax, n, p.o.MaxPerPage)
+			}
+			perPage = n
+		}
+	}
+
+	if p.o.LimitOffset {
+		offset := 0
+		if v := q.Get(p.o.OffsetParam); v != "" {
+			n, err := p.atoi(v)
+			if err != nil || n < 0 {
+				return Set{}, fmt.Errorf("%w: %q", ErrInvalidOffset, v)
+			}
+			if p.o.MaxOffset > 0 && n > p.o.MaxOffset {
+				return Set{}, fmt.Errorf("%w: %d > %d", ErrOffsetExceedsMax, n, p.o.MaxOffset)
+			}
+			offset = n
+		}
+		return p.newFromUrlOffset(q, offset, perPage), nil
+	}
+
+	page := 0
+	if v := q.Get(p.o.PageParam); v != "" {
+		n, err := p.atoi(v)
+		if err != nil || p.pageFromParam(n) < 1 {
+			return Set{}, fmt.Errorf("%w: %q", ErrInvalidPage, v)
+		}
+		page = p.pageFromParam(n)
+	}
+
+	if p.o.MaxPage > 0 && page > p.o.MaxPage {
+		return Set{}, fmt.Errorf("%w: %d > %d", ErrPageExceedsMax, page, p.o.MaxPage)
+	}
+	if last := p.maxPage(p.New(1, perPage).PerPage); page > last {
+		return Set{}, fmt.Errorf("%w: page %d", ErrOffsetExceedsMax, page)
+	}
+
+	return p.newFromUrl(q, page, perPage), nil
+}
+
+// atoi parses the number of a query param value according to Option.Parse.
+// All parsers of the package go through it.
+func (p *Paginator) atoi(v string) (int, error) {
+	if p.o.Parse != ParseLenient {
+		return strconv.Atoi(v)
+	}
+
+	v = strings.TrimSpace(v)
+	end := 0
+	if end < len(v) && (v[end] == '-' || v[end] == '+') {
+		end++
+	}
+	for end < len(v) && v[end] >= '0' && v[end] <= '9' {
+		end++
+	}
+	return strconv.Atoi(v[:end])
+}
+
+// strictPerPage parses the per page query param value v for parsers that
+// return errors. In ParseStrict mode an invalid value is an error wrapping
+// ErrInvalidPerPage, otherwise it falls back to the default per page.
+func (p *Paginator) strictPerPage(v string) (int, error) {
+	n, err := p.atoi(v)
+	if v != "" && (err != nil || n < 1) && p.o.Parse == ParseStrict {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidPerPage, v)
+	}
+	return n, nil
+}
+
+// pageFromParam returns the 1-based page for the page number n of a query
+// param, which starts at 0 if Option.ZeroIndexed is set.
+func (p *Paginator) pageFromParam(n int) int {
+	if p.o.ZeroIndexed {
+		return n + 1
+	}
+	return n
+}
+
+// paramPage returns the page number of the 1-based page for query params and
+// URLs, which starts at 0 if Option.ZeroIndexed is set.
+func (s *Set) paramPage(page int) int {
+	if s.pg.o.ZeroIndexed {
+		return page - 1
+	}
+	return page
+}
+
+// pageURL returns uri formatted with the page number of page.
+func (s *Set) pageURL(uri string, page int) string {
+	return fmt.Sprintf(uri, s.paramPage(page))
+}
+
+// newFromUrl returns a new paginator set for the parsed page and per page,
+// with the cursor from the query params.
+func (p *Paginator) newFromUrl(q url.Values, page, perPage int) Set {
+	return p.withCursor(q, p.New(page, perPage))
+}
+
+// newFromUrlOffset is like newFromUrl for a parsed offset and limit.
+func (p *Paginator) newFromUrlOffset(q url.Values, offset, perPage int) Set {
+	return p.withCursor(q, p.newFromOffset(offset, perPage))
+}
+
+// withCursor returns s with the cursor from the query params.
+func (p *Paginator) withCursor(q url.Values, s Set) Set {
+	s.cursor = q.Get(p.o.CursorParam)
+	if b := q.Get(p.o.BeforeParam); b != "" {
+		s.cursor = b
+		s.before = true
+	}
+	return s
+}
+
+// New returns a new paginator set.
+func (p *Paginator) New(page, perPage int) Set {
+	if perPage < 0 && p.o.AllowAll {
+		perPage = 0
+	} else if perPage < 1 {
+		perPage = p.o.DefaultPerPage
+	} else if !p.o.AllowAll && perPage > p.o.MaxPerPage {
+		perPage = p.o.MaxPerPage
+	}
+
+	if perPage > 0 && len(p.o.PerPageOptions) > 0 {
+		perPage = p.snapPerPage(perPage)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	// Pages grow up to MaxPerPage items, so their offsets are bounded by
+	// the offsets of pages of MaxPerPage items.
+	grows := p.o.GrowthFactor > 1 && p.o.MaxPerPage > 0 && perPage > 0
+	if grows {
+		page = min(page, p.maxPage(max(perPage, p.o.MaxPerPage)))
+	} else {
+		page = min(page, p.maxPage(perPage))
+	}
+
+	s := Set{
+		Page:    page,
+		PerPage: perPage,
+		Offset:  (page - 1) * perPage,
+		Limit:   perPage,
+		pg:      p,
+	}
+
+	if p.o.PinnedPrefix > 0 && perPage > 0 {
+		pinned := p.o.PinnedPrefix
+		if pinned > perPage {
+			pinned = perPage
+		}
+
+		if page == 1 {
+			s.Limit -= pinned
+			s.Empty = s.Limit == 0
+		} else {
+			s.Offset -= pinned
+		}
+	}
+
+	if p.o.FeaturedFirstCount > 0 {
+		if page == 1 {
+			s.Limit = p.o.FeaturedFirstCount
+		} else {
+			s.Offset = (page - 2) * perPage
+		}
+	}
+
+	if grows {
+		s.basePerPage = perPage
+		s.PerPage = p.grownPerPage(perPage, page)
+		s.Limit = s.PerPage
+		s.Offset = p.grownOffset(perPage, page)
+	}
+
+	return s
+}
+
+// grownPerPage returns the number of items on the given page when the
+// number of items per page grows by Option.GrowthFactor every page, starting
+// with perPage items on the first page and capped at MaxPerPage.
+func (p *Paginator) grownPerPage(perPage, page int) int {
+	n := float64(perPage) * math.Pow(p.o.GrowthFactor, float64(page-1))
+	if n >= float64(p.o.MaxPerPage) {
+		return p.o.MaxPerPage
+	}
+	return int(n)
+}
+
+// grownPage returns the page that offset falls on when the number of items
+// per page grows by Option.GrowthFactor, the inverse of grownOffset.
+func (p *Paginator) grownPage(perPage, offset int) int {
+	off := 0
+	for page := 1; ; page++ {
+		n := p.grownPerPage(perPage, page)
+
+		// Every page from here on has MaxPerPage items.
+		if n == p.o.MaxPerPage {
+			return page + (offset-off)/n
+		}
+		if off+n > offset {
+			return page
+		}
+		off += n
+	}
+}
+
+// grownOffset returns the offset of the given page when the number of items
+// per page grows by Option.GrowthFactor, i.e the sum of the number of items
+// on all the pages before it.
+func (p *Paginator) grownOffset(perPage, page int) int {
+	off := 0
+	for i := 1; i < page; i++ {
+		n := p.grownPerPage(perPage, i)
+
+		// Every page from here on has MaxPerPage items.
+		if n == p.o.MaxPerPage {
+			return off + (page-i)*n
+		}
+		off += n
+	}
+	return off
+}
+
+// maxPage returns the highest page that can be requested with perPage items
+// per page, within Option.MaxPage and Option.MaxOffset. It is bounded by
+// math.MaxInt/perPage, so that neither the offset of the page nor the end of
+// its range overflows.
+func (p *Paginator) maxPage(perPage int) int {
+	last := math.MaxInt
+	if p.o.MaxPage > 0 {
+		last = p.o.MaxPage
+	}
+	if perPage > 0 {
+		last = min(last, math.MaxInt/perPage)
+		if p.o.MaxOffset > 0 {
+			last = min(last, p.o.MaxOffset/perPage+1)
+		}
+	}
+	return last
+}
+
+// snapPerPage returns the value of Option.PerPageOptions nearest to perPage.
+func (p *Paginator) snapPerPage(perPage int) int {
+	best := p.o.PerPageOptions[0]
+	for _, v := range p.o.PerPageOptions[1:] {
+		if abs(v-perPage) < abs(best-perPage) {
+			best = v
+		}
+	}
+	return best
+}
+
+// abs returns the absolute value of n.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// PerPageOptions returns the allowed numbers of items per page from
+// Option.PerPageOptions in ascending order, for rendering a page size
+// selector with the set's PerPage selected. It is empty if any number is
+// allowed.
+func (s *Set) PerPageOptions() []int {
+	return slices.Clone(s.pg.o.PerPageOptions)
+}
+
+// newFromOffset returns a new paginator set starting at an arbitrary offset
+// instead of a page, for params that give the offset directly. The Page is
+// the one the offset falls on.
+func (p *Paginator) newFromOffset(offset, perPage int) Set {
+	if p.o.MaxOffset > 0 {
+		offset = min(offset, p.o.MaxOffset)
+	}
+
+	s := p.New(1, perPage)
+	switch {
+	case s.basePerPage > 0:
+		s.Page = p.grownPage(s.basePerPage, offset)
+		if last := p.maxPage(max(s.basePerPage, p.o.MaxPerPage)); s.Page > last {
+			s.Page, offset = last, p.grownOffset(s.basePerPage, last)
+		}
+		s.PerPage = p.grownPerPage(s.basePerPage, s.Page)
+		s.Limit = s.PerPage
+	case s.PerPage > 0:
+		s.Page = offset/s.PerPage + 1
+
+		// Past Option.MaxPage, start at the last page that can be requested.
+		if last := p.maxPage(s.PerPage); s.Page > last {
+			s.Page, offset = last, (last-1)*s.PerPage
+		}
+	}
+	s.Offset = offset
+	return s
+}
+
+// NewCapped returns a new paginator set for a mixed feed where at most
+// perTypeCap items of each of the given number of types may appear on a page.
+// The Limit is reduced to min(perPage, perTypeCap*types) while the Offset is
+// still computed from the (clamped) perPage so that page boundaries stay the
+// same as an uncapped set. Items past the cap on a page are not shifted onto
+// the next page.
+func (p *Paginator) NewCapped(page, perPage int, perTypeCap int, types int) Set {
+	s := p.New(page, perPage)
+	if perTypeCap < 1 || types < 1 {
+		return s
+	}
+
+	if c := perTypeCap * types; s.Limit == 0 || c < s.Limit {
+		s.Limit = c
+	}
+	return s
+}
+
+// NewRing returns a new paginator set for paging through a fixed capacity
+// ring buffer whose logical first item is stored at the physical index head.
+// Offset and Limit are logical positions counted from head and the total is
+// set to the capacity. Use RingIndices with the head and capacity from Ring
+// to map the page onto physical indices.
+func (p *Paginator) NewRing(head, capacity, page, perPage int) Set {
+	s := p.New(page, perPage)
+	s.SetTotal(capacity)
+	s.ringHead, s.ringCap = head, capacity
+	return s
+}
+
+// ValidateFrozenTotal returns an error if a total claimed by a client differs
+// from the actual total by more than Option.FrozenTotalTolerance.
+func (p *Paginator) ValidateFrozenTotal(claimed, actual int) error {
+	d := claimed - actual
+	if d < 0 {
+		d = -d
+	}
+
+	if d > p.o.FrozenTotalTolerance {
+		return fmt.Errorf("claimed total %d differs from actual total %d by more than %d", claimed, actual, p.o.FrozenTotalTolerance)
+	}
+	return nil
+}
+
+// NewGrid returns a new paginator set where every page is a full grid of
+// rows x cols items. PerPage is set to rows*cols and is not clamped to
+// MaxPerPage, as the grid size is dictated by the layout. The page is still
+// limited by Option.MaxPage and Option.MaxOffset.
+func (p *Paginator) NewGrid(page, rows, cols int) Set {
+	if rows < 1 {
+		rows = 1
+	}
+	if cols < 1 {
+		cols = 1
+	}
+
+	s := p.New(min(page, p.maxPage(rows*cols)), 1)
+	s.PerPage = rows * cols
+	s.Offset = (s.Page - 1) * s.PerPage
+	s.Limit = s.PerPage
+	s.gridRows, s.gridCols = rows, cols
+	return s
+}
+
+// NewTimeBucket returns a new paginator set for time bucketed data between
+// startUnix and endUnix where every item is a bucket of bucketSeconds and
+// every page holds bucketsPerPage buckets. The total is set to the number of
+// buckets in the span. Use TimeRange to get the span of the requested page.
+func (p *Paginator) NewTimeBucket(startUnix, endUnix, bucketSeconds, page, bucketsPerPage int) Set {
+	if bucketSeconds < 1 {
+		bucketSeconds = 1
+	}
+
+	s := p.New(page, bucketsPerPage)
+	s.timeStart, s.timeEnd, s.bucketSeconds = int64(startUnix), int64(endUnix), int64(bucketSeconds)
+	if endUnix > startUnix {
+		s.SetTotal(int(math.Ceil(float64(endUnix-startUnix) / float64(bucketSeconds))))
+	}
+	return s
+}
+
+// NewUnion returns a new paginator set over the union of two result sets
+// with countA and countB items, where all items of A come before those of B.
+// The total is set to countA+countB. Use UnionBounds to get the slice of
+// each source on the current page.
+func (p *Paginator) NewUnion(page, perPage int, countA, countB int) Set {
+	s := p.New(page, perPage)
+	s.SetTotal(countA + countB)
+	return s
+}
+
+// NewWithExclusions returns a new paginator set for a source that contains
+// soft deleted placeholders (tombstones) which occupy positions in the source
+// but are not counted as items. excludedBefore returns the number of
+// tombstones that precede the given source offset. The Offset is moved past
+// the tombstones preceding the first item of the page, so that it can be used
+// as the offset in the source query, up to Option.MaxOffset. The Limit is
+// unchanged and tombstones within the page still have to be filtered out by
+// the query.
+func (p *Paginator) NewWithExclusions(page, perPage int, excludedBefore func(offset int) int) Set {
+	s := p.New(page, perPage)
+	if excludedBefore == nil {
+		return s
+	}
+
+	// Moving the offset past tombstones can place more tombstones before it,
+	// so repeat until the offset settles.
+	off := s.Offset
+	for {
+		n := s.Offset + excludedBefore(off)
+		if n <= off {
+			break
+		}
+		off = n
+		if p.o.MaxOffset > 0 && off >= p.o.MaxOffset {
+			off = p.o.MaxOffset
+			break
+		}
+	}
+	s.Offset = off
+	return s
+}
+
+// SetTotal sets the total number of items and generates the page numbers.
+func (s *Set) SetTotal(t int) {
+	s.Total = t
+	s.Restricted = s.pg.o.VisibleLimit > 0 && t > s.pg.o.VisibleLimit
+	s.generateNumbers()
+}
+
+// setNavPages sets the first, previous, next and last pages of the set
+// for the given number of pages.
+func (s *Set) setNavPages(numPages int) {
+	s.FirstPage, s.PrevPage, s.NextPage, s.LastPage = 1, 0, 0, numPages
+	if s.Page > 1 {
+		s.PrevPage = min(s.Page-1, numPages)
+	}
+	if s.Page < numPages {
+		s.NextPage = s.Page + 1
+	}
+}
+
+// HasPrev reports whether there is a page before the current one.
+// It requires the total to be set with SetTotal.
+func (s *Set) HasPrev() bool {
+	return s.PrevPage > 0
+}
+
+// HasNext reports whether there is a page after the current one.
+// It requires the total to be set with SetTotal.
+func (s *Set) HasNext() bool {
+	return s.NextPage > 0
+}
+
+// OutOfRange reports whether the current page is past the last page, e.g to
+// respond with 404 Not Found. The first page is never out of range, even if
+// there are no items. It requires the total to be set with SetTotal.
+func (s *Set) OutOfRange() bool {
+	return s.Page > s.lastPage()
+}
+
+// visibleTotal returns the number of items that can be paginated through,
+// which is the total capped at Option.VisibleLimit.
+func (s *Set) visibleTotal() int {
+	if s.Restricted {
+		return s.pg.o.VisibleLimit
+	}
+	return s.Total
+}
+
+func (s *Set) generateNumbers() {
+	numPages := s.lastPage()
+	if s.Total > 0 {
+		s.TotalPages = numPages
+	}
+	s.setNavPages(numPages)
+	if numPages <= 1 {
+		return
+	}
+
+	// Few enough pages to show all of them.
+	if numPages <= s.pg.o.FullBelow {
+		s.Pages = pageRange(1, numPages)
+		return
+	}
+
+	pages := s.pg.window().Window(s.Page, numPages)
+	if len(pages) == 0 {
+		return
+	}
+
+	// Fill gaps that are too small for an ellipsis with their page numbers.
+	var (
+		first = pages[0]
+		last  = pages[len(pages)-1]
+	)
+	if first > 1 && first-2 < s.pg.o.MinGapForEllipsis {
+		first = 1
+	}
+	if last < numPages && numPages-last-1 < s.pg.o.MinGapForEllipsis {
+		last = numPages
+	}
+	s.Pages = pageRange(first, pages[0]-1)
+	for i, p := range pages {
+		if i > 0 && p-pages[i-1]-1 < s.pg.o.MinGapForEllipsis {
+			s.Pages = append(s.Pages, pageRange(pages[i-1]+1, p-1)...)
+		}
+		s.Pages = append(s.Pages, p)
+	}
+	s.Pages = append(s.Pages, pageRange(pages[len(pages)-1]+1, last)...)
+
+	// If first in the page number series isn't 1, pin it.
+	pin := s.pg.o.Pin
+	if first != 1 && (pin == PinBoth || pin == PinFirst) {
+		s.PinFirstPage = true
+	}
+
+	// If last page in the page number series is not the actual last page,
+	// pin it.
+	if last != numPages && (pin == PinBoth || pin == PinLast) {
+		s.PinLastPage = true
+	}
+}
+
+// ChangePerPage changes the number of items per page on an existing set,
+// re-clamping it against the paginator's limits and recomputing Offset and
+// Limit. If a total was already set, the page numbers are regenerated. The
+// current page is preserved unless it no longer exists with the new size,
+// in which case the last page is selected.
+func (s *Set) ChangePerPage(newPerPage int) {
+	n := s.pg.New(s.Page, newPerPage)
+	if s.Total > 0 {
+		if n.PerPage > 0 {
+			if last := int(math.Ceil(float64(s.Total) / float64(n.PerPage))); n.Page > last {
+				n = s.pg.New(last, newPerPage)
+			}
+		}
+		n.SetTotal(s.Total)
+	}
+	n.ringHead, n.ringCap = s.ringHead, s.ringCap
+	*s = n
+}
+
+// RingIndices returns the physical indices in a ring buffer of the given
+// capacity, whose logical first item is stored at the physical index head,
+// for the items on the current page. Indices wrap around the end of the
+// buffer. It returns an empty slice if the capacity is less than 1.
+func (s *Set) RingIndices(head, capacity int) []int {
+	if capacity < 1 {
+		return []int{}
+	}
+
+	end := s.Offset + s.Limit
+	if s.Limit == 0 || end > capacity {
+		end = capacity
+	}
+
+	out := []int{}
+	for i := s.Offset; i < end; i++ {
+		out = append(out, (head+i)%capacity)
+	}
+	return out
+}
+
+// AdjacentRanges returns the offset and limit of the previous and next pages,
+// clamped to the total, for prefetching their items. hasPrev and hasNext
+// report whether the respective page has items to fetch. The ranges are those of the
+// sets New returns for the pages, so they follow Option.PinnedPrefix,
+// Option.FeaturedFirstCount and Option.GrowthFactor like the set itself.
+func (s *Set) AdjacentRanges() (prev, next struct{ Offset, Limit int }, hasPrev, hasNext bool) {
+	if s.PerPage < 1 {
+		return
+	}
+
+	adjacent := func(page int) (r struct{ Offset, Limit int }, ok bool) {
+		n := s.pg.New(page, s.requestedPerPage())
+		if n.Page != page || n.Offset >= s.Total || n.Empty {
+			return r, false
+		}
+		r.Offset, r.Limit = n.Offset, min(n.Limit, s.Total-n.Offset)
+		return r, true
+	}
+
+	if s.Page > 1 {
+		prev, hasPrev = adjacent(s.Page - 1)
+	}
+	next, hasNext = adjacent(s.Page + 1)
+	return
+}
+
+// GridDims returns the rows and columns of a set created with NewGrid.
+// Both are 0 for other sets.
+func (s *Set) GridDims() (rows, cols int) {
+	return s.gridRows, s.gridCols
+}
+
+// Ring returns the head and capacity of a set created with NewRing, e.g for
+// s.RingIndices(s.Ring()). Both are 0 for other sets.
+func (s *Set) Ring() (head, capacity int) {
+	return s.ringHead, s.ringCap
+}
+
+// ClampToTotal moves the set to the last page if, after SetTotal, the offset
+// lies past the total, e.g when the data set has shrunk since the page was
+// requested. Offset, Limit and the page numbers are recomputed.
+func (s *Set) ClampToTotal() {
+	if s.Total < 1 || s.PerPage < 1 || s.Offset < s.visibleTotal() {
+		return
+	}
+
+	n := s.pg.New(s.lastPage(), s.requestedPerPage())
+	n.SetTotal(s.Total)
+	n.ringHead, n.ringCap = s.ringHead, s.ringCap
+	*s = n
+}
+
+// requestedPerPage returns the number of items per page the set was created
+// with, which is the size of the first page when Option.GrowthFactor is set.
+func (s *Set) requestedPerPage() int {
+	if s.basePerPage > 0 {
+		return s.basePerPage
+	}
+	return s.PerPage
+}
+
+// lastPage returns the number of the last page for the total, which is at
+// least 1. Unlike TotalPages, it is also set when everything fits on one page.
+func (s *Set) lastPage() int {
+	total := s.visibleTotal()
+	if s.PerPage < 1 {
+		return 1
+	}
+
+	// Count the pages of growing size it takes to reach the total.
+	if s.basePerPage > 0 {
+		n := 1
+		for seen := s.pg.grownPerPage(s.basePerPage, 1); seen < total; n++ {
+			pp := s.pg.grownPerPage(s.basePerPage, n+1)
+			if pp == s.pg.o.MaxPerPage {
+				return n + int(math.Ceil(float64(total-seen)/float64(pp)))
+			}
+			seen += pp
+		}
+		return n
+	}
+
+	// The featured first page comes before the pages of the normal source.
+	if s.pg.o.FeaturedFirstCount > 0 {
+		return 1 + int(math.Ceil(float64(total)/float64(s.PerPage)))
+	}
+
+	// The pinned items take up the start of the first page.
+	if s.pg.o.PinnedPrefix > 0 {
+		total += min(s.pg.o.PinnedPrefix, s.PerPage)
+	}
+
+	if total <= s.PerPage {
+		return 1
+	}
+	return int(math.Ceil(float64(total) / float64(s.PerPage)))
+}
+
+// RebaseForPerPage returns the page that contains the first item of the
+// current page when paginating with newPerPage items per page, so that users
+// keep seeing the same content after changing the page size. The set is not
+// modified.
+func (s *Set) RebaseForPerPage(newPerPage int) (newPage int) {
+	if newPerPage < 1 {
+		return 1
+	}
+	return s.Offset/newPerPage + 1
+}
+
+// TimeRange returns the start and end unix time of the buckets on the current
+// page of a set created with NewTimeBucket. The end is clamped to the end of
+// the span.
+func (s *Set) TimeRange() (start, end int64) {
+	start = s.timeStart + int64(s.Offset)*s.bucketSeconds
+	end = s.timeEnd
+	if s.Limit > 0 {
+		if e := start + int64(s.Limit)*s.bucketSeconds; e < end {
+			end = e
+		}
+	}
+	if start > end {
+		start = end
+	}
+	return start, end
+}
+
+// ItemRange returns the 1-based indices of the first and last items on the
+// current page, clamped to the total. Both are 0 if the page is empty. With
+// Option.FeaturedFirstCount, the featured items are numbered first and the
+// items of the normal source after them.
+func (s *Set) ItemRange() (from, to int) {
+	featured := s.pg.o.FeaturedFirstCount
+	if featured > 0 && s.Page == 1 {
+		return 1, featured
+	}
+	if s.Total < 1 || s.Offset >= s.Total || s.Empty {
+		return 0, 0
+	}
+
+	to = s.Offset + s.Limit
+	if s.Limit == 0 || to > s.Total {
+		to = s.Total
+	}
+	return featured + s.Offset + 1, featured + to
+}
+
+// itemTotal returns the number of items ItemRange counts up to, which
+// includes the featured items of Option.FeaturedFirstCount.
+func (s *Set) itemTotal() int {
+	return s.Total + s.pg.o.FeaturedFirstCount
+}
+
+// From returns the 1-based index of the first item on the current page, e.g 21
+// for "Showing 21–30 of 187 results", or 0 if the page is empty.
+func (s *Set) From() int {
+	from, _ := s.ItemRange()
+	return from
+}
+
+// To returns the 1-based index of the last item on the current page, which is
+// the total on the last page, or 0 if the page is empty.
+func (s *Set) To() int {
+	_, to := s.ItemRange()
+	return to
+}
+
+// ItemSummary returns a summary of the items on the current page,
+// e.g "Item 51–75 of 487", or "No items" if the page is empty.
+func (s *Set) ItemSummary() string {
+	from, to := s.ItemRange()
+	if from == 0 {
+		return "No items"
+	}
+	return fmt.Sprintf("Item %d–%d of %d", from, to, s.itemTotal())
+}
+
+// UnionBounds returns the offset and limit to query each of the two sources
+// of a set created with NewUnion for the items on the current page. A limit
+// of 0 means that the source has no items on the page.
+func (s *Set) UnionBounds(countA int) (offsetA, limitA, offsetB, limitB int) {
+	end := s.Offset + s.Limit
+	if s.Limit == 0 || end > s.Total {
+		end = s.Total
+	}
+
+	if s.Offset < countA {
+		offsetA = s.Offset
+		limitA = countA - s.Offset
+		if end < countA {
+			limitA = end - s.Offset
+		}
+	}
+
+	if end > countA {
+		start := s.Offset
+		if start < countA {
+			start = countA
+		}
+		offsetB = start - countA
+		limitB = end - start
+	}
+	return
+}
+
+// StreamSkipTake returns the number of items to skip and take from a merge
+// sorted stream of shards for the current page, i.e Offset and Limit.
+func (s *Set) StreamSkipTake() (skip, take int) {
+	return s.Offset, s.Limit
+}
+
+// StreamPeek returns the maximum number of items any one of the given number
+// of shards may contribute to the current page, i.e skip+take, which bounds
+// how many items to read from each shard into the merge heap.
+func (s *Set) StreamPeek(shards int) int {
+	if shards < 1 {
+		return 0
+	}
+
+	skip, take := s.StreamSkipTake()
+	return skip + take
+}
+
+// Repaginate moves the set to newPage, recomputing Offset, Limit and the page
+// number series from the already known Total without going through SetTotal.
+// Other values on the set are preserved.
+func (s *Set) Repaginate(newPage int) {
+	n := s.pg.New(newPage, s.requestedPerPage())
+	s.Page, s.PerPage, s.Offset, s.Limit = n.Page, n.PerPage, n.Offset, n.Limit
+	s.PinFirstPage, s.PinLastPage, s.Pages = false, false, nil
+	s.generateNumbers()
+}
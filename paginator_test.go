@@ -0,0 +1,745 @@
+package paginator
+
+import (
+	"errors"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestPinnedPrefix(t *testing.T) {
+	o := Default()
+	o.PinnedPrefix = 3
+	p := New(o)
+
+	cases := []struct {
+		page, offset, limit int
+	}{
+		{1, 0, 7},
+		{2, 7, 10},
+		{3, 17, 10},
+	}
+	for _, c := range cases {
+		s := p.New(c.page, 10)
+		if s.Offset != c.offset || s.Limit != c.limit {
+			t.Errorf("page %d: offset, limit = %d, %d, want %d, %d", c.page, s.Offset, s.Limit, c.offset, c.limit)
+		}
+	}
+
+	s := p.New(1, 10)
+	s.SetTotal(10)
+	if s.TotalPages != 2 || !s.HasNext() {
+		t.Errorf("total pages, has next = %d, %v, want 2, true", s.TotalPages, s.HasNext())
+	}
+
+	s = p.New(2, 10)
+	s.SetTotal(10)
+	if s.OutOfRange() {
+		t.Error("page 2 is out of range")
+	}
+
+	items := 0
+	for s := range p.Pages(10, 10) {
+		items += min(s.Limit, 10-s.Offset)
+	}
+	if items != 10 {
+		t.Errorf("pages cover %d items, want 10", items)
+	}
+
+	// A prefix of more than a page fills the first page.
+	o.PinnedPrefix = 15
+	p = New(o)
+	s = p.New(1, 10)
+	if s.Offset != 0 || s.Limit != 0 || !s.Empty {
+		t.Errorf("page 1 with a full prefix: offset, limit, empty = %d, %d, %t, want 0, 0, true", s.Offset, s.Limit, s.Empty)
+	}
+	if got := PaginateSliceTotal(make([]int, 30), &s); len(got) != 0 {
+		t.Errorf("page 1 with a full prefix: PaginateSlice() = %d items, want 0", len(got))
+	}
+	if from, to := s.ItemRange(); from != 0 || to != 0 {
+		t.Errorf("page 1 with a full prefix: ItemRange() = %d, %d, want 0, 0", from, to)
+	}
+	if s := p.New(2, 10); s.Offset != 0 || s.Limit != 10 {
+		t.Errorf("page 2 with a full prefix: offset, limit = %d, %d, want 0, 10", s.Offset, s.Limit)
+	}
+}
+
+func TestFeaturedFirstCount(t *testing.T) {
+	o := Default()
+	o.FeaturedFirstCount = 5
+	p := New(o)
+
+	cases := []struct {
+		page, offset, limit int
+		summary, rng        string
+	}{
+		{1, 0, 5, "Item 1–5 of 35", "items 0-4/35"},
+		{2, 0, 10, "Item 6–15 of 35", "items 5-14/35"},
+		{3, 10, 10, "Item 16–25 of 35", "items 15-24/35"},
+		{4, 20, 10, "Item 26–35 of 35", "items 25-34/35"},
+	}
+	for _, c := range cases {
+		s := p.New(c.page, 10)
+		if s.Offset != c.offset || s.Limit != c.limit {
+			t.Errorf("page %d: offset, limit = %d, %d, want %d, %d", c.page, s.Offset, s.Limit, c.offset, c.limit)
+		}
+
+		s.SetTotal(30)
+		if s.TotalPages != 4 {
+			t.Errorf("page %d: total pages = %d, want 4", c.page, s.TotalPages)
+		}
+		if got := s.ItemSummary(); got != c.summary {
+			t.Errorf("page %d: ItemSummary() = %q, want %q", c.page, got, c.summary)
+		}
+		if got := s.ContentRange(); got != c.rng {
+			t.Errorf("page %d: ContentRange() = %q, want %q", c.page, got, c.rng)
+		}
+	}
+}
+
+func TestGrowthFactor(t *testing.T) {
+	o := Default()
+	o.GrowthFactor = 2
+	p := New(o)
+
+	s := p.New(3, 10)
+	if s.Offset != 30 || s.Limit != 40 {
+		t.Fatalf("page 3: offset, limit = %d, %d, want 30, 40", s.Offset, s.Limit)
+	}
+
+	s.SetTotal(1000)
+	s.Repaginate(4)
+	s.Repaginate(3)
+	if s.Offset != 30 || s.Limit != 40 || s.PerPage != 40 {
+		t.Errorf("repaginated page 3: offset, limit, per page = %d, %d, %d, want 30, 40, 40", s.Offset, s.Limit, s.PerPage)
+	}
+
+	s = p.New(9, 10)
+	s.SetTotal(100)
+	s.ClampToTotal()
+	if s.Page != 4 || s.Offset != 70 || s.Limit != 50 || s.TotalPages != 4 {
+		t.Errorf("clamped: page, offset, limit, total pages = %d, %d, %d, %d, want 4, 70, 50, 4",
+			s.Page, s.Offset, s.Limit, s.TotalPages)
+	}
+
+	if s := p.New(math.MaxInt/10, 10); s.Offset < 0 || s.Offset > math.MaxInt-s.Limit {
+		t.Errorf("deep page: offset %d overflows", s.Offset)
+	}
+}
+
+func TestMaxOffsetGuard(t *testing.T) {
+	o := Default()
+	o.MaxOffset = 100
+	p := New(o)
+
+	if s := p.NewGrid(1000, 3, 4); s.Page != 9 || s.Offset != 96 {
+		t.Errorf("grid: page, offset = %d, %d, want 9, 96", s.Page, s.Offset)
+	}
+
+	if s := p.newFromOffset(5000, 10); s.Page != 11 || s.Offset != 100 {
+		t.Errorf("offset: page, offset = %d, %d, want 11, 100", s.Page, s.Offset)
+	}
+
+	excluded := func(offset int) int { return offset / 2 }
+	if s := p.NewWithExclusions(8, 10, excluded); s.Offset != 100 {
+		t.Errorf("exclusions: offset = %d, want 100", s.Offset)
+	}
+
+	o = Default()
+	o.MaxPage = 5
+	p = New(o)
+	if s := p.newFromOffset(5000, 10); s.Page != 5 || s.Offset != 40 {
+		t.Errorf("max page: page, offset = %d, %d, want 5, 40", s.Page, s.Offset)
+	}
+}
+
+func TestHugePage(t *testing.T) {
+	p := New(Default())
+	q := url.Values{"page": {strconv.Itoa(math.MaxInt)}, "per_page": {"10"}}
+
+	s := p.NewFromUrl(q)
+	if s.Offset < 0 || s.Offset > math.MaxInt-s.Limit {
+		t.Fatalf("offset %d overflows", s.Offset)
+	}
+	s.SetTotal(100)
+	if got := s.ContentRange(); got != "items */100" {
+		t.Errorf("ContentRange() = %q, want %q", got, "items */100")
+	}
+	if got := PaginateSliceTotal(make([]int, 100), &s); len(got) != 0 {
+		t.Errorf("PaginateSliceTotal() = %d items, want 0", len(got))
+	}
+
+	if _, err := p.NewFromUrlStrict(q); !errors.Is(err, ErrOffsetExceedsMax) {
+		t.Errorf("NewFromUrlStrict() err = %v, want %v", err, ErrOffsetExceedsMax)
+	}
+}
+
+func TestParseModes(t *testing.T) {
+	q := url.Values{"per_page": {"25abc"}, "limit": {"25abc"}, "$top": {"25 "}}
+
+	o := Default()
+	o.Parse = ParseStrict
+	p := New(o)
+	if _, err := p.NewFromCursor(q); !errors.Is(err, ErrInvalidPerPage) {
+		t.Errorf("strict NewFromCursor() err = %v, want %v", err, ErrInvalidPerPage)
+	}
+	if _, err := p.NewFromStripe(q); !errors.Is(err, ErrInvalidPerPage) {
+		t.Errorf("strict NewFromStripe() err = %v, want %v", err, ErrInvalidPerPage)
+	}
+	if _, err := p.NewFromOData(q); !errors.Is(err, ErrInvalidPerPage) {
+		t.Errorf("strict NewFromOData() err = %v, want %v", err, ErrInvalidPerPage)
+	}
+
+	o.Parse = ParseFallback
+	p = New(o)
+	if c, err := p.NewFromCursor(q); err != nil || c.PerPage != o.DefaultPerPage {
+		t.Errorf("fallback NewFromCursor() = %d, %v, want %d", c.PerPage, err, o.DefaultPerPage)
+	}
+
+	o.Parse = ParseLenient
+	p = New(o)
+	if c, err := p.NewFromStripe(q); err != nil || c.PerPage != 25 {
+		t.Errorf("lenient NewFromStripe() = %d, %v, want 25", c.PerPage, err)
+	}
+	if s, err := p.NewFromOData(q); err != nil || s.Limit != 25 {
+		t.Errorf("lenient NewFromOData() = %d, %v, want 25", s.Limit, err)
+	}
+}
+
+func TestRingIndices(t *testing.T) {
+	p := New(Default())
+
+	s := p.NewRing(8, 10, 2, 3)
+	if got, want := s.RingIndices(8, 10), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("RingIndices(8, 10) = %v, want %v", got, want)
+	}
+
+	s.ChangePerPage(5)
+	if got, want := s.RingIndices(s.Ring()), []int{3, 4, 5, 6, 7}; !slices.Equal(got, want) {
+		t.Errorf("RingIndices(Ring()) after ChangePerPage = %v, want %v", got, want)
+	}
+
+	s = p.New(1, 3)
+	if head, capacity := s.Ring(); head != 0 || capacity != 0 {
+		t.Errorf("Ring() without ring = %d, %d, want 0, 0", head, capacity)
+	}
+	if got := s.RingIndices(0, 0); len(got) != 0 {
+		t.Errorf("RingIndices(0, 0) = %v, want none", got)
+	}
+}
+
+func TestInvalidCursor(t *testing.T) {
+	o := Default()
+	o.CursorSecret = []byte("secret")
+	p := New(o)
+
+	token := p.EncodeCursor(Cursor{Keys: []interface{}{"a"}})
+	for _, tok := range []string{"", "!!", token[:len(token)-1], "e30"} {
+		if _, err := p.DecodeCursor(tok); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("DecodeCursor(%q) err = %v, want %v", tok, err, ErrInvalidCursor)
+		}
+	}
+	if _, err := p.NewFromSignedToken("e30.x", o.CursorSecret); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("NewFromSignedToken() err = %v, want %v", err, ErrInvalidCursor)
+	}
+	if _, _, err := NewKeyset(Asc("id"), Asc("name")).Where(Cursor{Keys: []interface{}{1}}); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Where() err = %v, want %v", err, ErrInvalidCursor)
+	}
+}
+
+func TestFullBelow(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 5
+	o.FullBelow = 12
+
+	tests := []struct {
+		total   int
+		want    []int
+		wantPin bool
+	}{
+		{110, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, false},
+		{120, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, false},
+		{130, []int{5, 6, 7, 8, 9}, true},
+	}
+	for _, tc := range tests {
+		s := BuildSet(7, 10, tc.total, o)
+		if !slices.Equal(s.Pages, tc.want) {
+			t.Errorf("total %d: Pages = %v, want %v", tc.total, s.Pages, tc.want)
+		}
+		if s.PinFirstPage != tc.wantPin || s.PinLastPage != tc.wantPin {
+			t.Errorf("total %d: pinned = %t, %t, want %t", tc.total, s.PinFirstPage, s.PinLastPage, tc.wantPin)
+		}
+	}
+}
+
+func TestNewCapped(t *testing.T) {
+	p := New(Default())
+	tests := []struct {
+		perTypeCap, types int
+		wantLimit         int
+	}{
+		{2, 3, 6},
+		{5, 4, 20},
+		{0, 3, 20},
+	}
+	for _, tc := range tests {
+		s := p.NewCapped(3, 20, tc.perTypeCap, tc.types)
+		if s.Limit != tc.wantLimit || s.Offset != 40 {
+			t.Errorf("NewCapped(3, 20, %d, %d): Offset, Limit = %d, %d, want 40, %d", tc.perTypeCap, tc.types, s.Offset, s.Limit, tc.wantLimit)
+		}
+	}
+}
+
+func TestChangePerPage(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 5
+
+	s := BuildSet(3, 10, 500, o)
+	s.ChangePerPage(50)
+	if s.Page != 3 || s.PerPage != 50 || s.Offset != 100 || s.Limit != 50 || s.TotalPages != 10 {
+		t.Errorf("ChangePerPage(50) = page %d, per page %d, offset %d, limit %d, total pages %d, want 3, 50, 100, 50, 10",
+			s.Page, s.PerPage, s.Offset, s.Limit, s.TotalPages)
+	}
+	if want := []int{1, 2, 3, 4, 5}; !slices.Equal(s.Pages, want) {
+		t.Errorf("ChangePerPage(50): Pages = %v, want %v", s.Pages, want)
+	}
+
+	// The page no longer exists with the new size.
+	s = BuildSet(40, 10, 500, o)
+	s.ChangePerPage(50)
+	if s.Page != 10 || s.Offset != 450 {
+		t.Errorf("ChangePerPage(50) past the end = page %d, offset %d, want 10, 450", s.Page, s.Offset)
+	}
+}
+
+func TestAdjacentRanges(t *testing.T) {
+	type r = struct{ Offset, Limit int }
+	tests := []struct {
+		page             int
+		prev, next       r
+		hasPrev, hasNext bool
+	}{
+		{3, r{10, 10}, r{30, 10}, true, true},
+		{1, r{}, r{10, 10}, false, true},
+		{5, r{30, 10}, r{}, true, false},
+		{4, r{20, 10}, r{40, 5}, true, true},
+	}
+	for _, tc := range tests {
+		s := BuildSet(tc.page, 10, 45, Default())
+		prev, next, hasPrev, hasNext := s.AdjacentRanges()
+		if prev != tc.prev || next != tc.next || hasPrev != tc.hasPrev || hasNext != tc.hasNext {
+			t.Errorf("page %d: AdjacentRanges() = %v, %v, %t, %t, want %v, %v, %t, %t",
+				tc.page, prev, next, hasPrev, hasNext, tc.prev, tc.next, tc.hasPrev, tc.hasNext)
+		}
+	}
+
+	pinned := Default()
+	pinned.PinnedPrefix = 3
+	featured := Default()
+	featured.FeaturedFirstCount = 5
+	grown := Default()
+	grown.GrowthFactor = 2
+	options := []struct {
+		name       string
+		o          Option
+		prev, next r
+	}{
+		{"pinned prefix", pinned, r{0, 7}, r{17, 10}},
+		{"featured first", featured, r{0, 5}, r{10, 10}},
+		{"growth", grown, r{0, 10}, r{30, 15}},
+	}
+	for _, tc := range options {
+		s := BuildSet(2, 10, 45, tc.o)
+		prev, next, hasPrev, hasNext := s.AdjacentRanges()
+		if prev != tc.prev || next != tc.next || !hasPrev || !hasNext {
+			t.Errorf("%s: AdjacentRanges() = %v, %v, %t, %t, want %v, %v, true, true",
+				tc.name, prev, next, hasPrev, hasNext, tc.prev, tc.next)
+		}
+	}
+}
+
+func TestValidateFrozenTotal(t *testing.T) {
+	o := Default()
+	o.FrozenTotalTolerance = 5
+	p := New(o)
+
+	tests := []struct {
+		claimed, actual int
+		wantErr         bool
+	}{
+		{100, 100, false},
+		{100, 105, false},
+		{105, 100, false},
+		{100, 106, true},
+		{94, 100, true},
+	}
+	for _, tc := range tests {
+		if err := p.ValidateFrozenTotal(tc.claimed, tc.actual); (err != nil) != tc.wantErr {
+			t.Errorf("ValidateFrozenTotal(%d, %d) = %v, want error %t", tc.claimed, tc.actual, err, tc.wantErr)
+		}
+	}
+}
+
+func TestNewGrid(t *testing.T) {
+	p := New(Default())
+
+	s := p.NewGrid(3, 3, 4)
+	if s.PerPage != 12 || s.Limit != 12 || s.Offset != 24 {
+		t.Errorf("NewGrid(3, 3, 4) = per page %d, limit %d, offset %d, want 12, 12, 24", s.PerPage, s.Limit, s.Offset)
+	}
+	if rows, cols := s.GridDims(); rows != 3 || cols != 4 {
+		t.Errorf("GridDims() = %d, %d, want 3, 4", rows, cols)
+	}
+
+	// The grid size is not clamped to MaxPerPage.
+	if s := p.NewGrid(1, 10, 10); s.PerPage != 100 {
+		t.Errorf("NewGrid(1, 10, 10).PerPage = %d, want 100", s.PerPage)
+	}
+}
+
+func TestBuildSet(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 5
+
+	want := New(o).New(4, 20)
+	want.SetTotal(333)
+	got := BuildSet(4, 20, 333, o)
+
+	if got.Page != want.Page || got.PerPage != want.PerPage || got.Offset != want.Offset || got.Limit != want.Limit ||
+		got.Total != want.Total || got.TotalPages != want.TotalPages || !slices.Equal(got.Pages, want.Pages) ||
+		got.PinFirstPage != want.PinFirstPage || got.PinLastPage != want.PinLastPage {
+		t.Errorf("BuildSet() = %+v, want %+v", got, want)
+	}
+}
+
+func TestClampToTotal(t *testing.T) {
+	s := BuildSet(10, 10, 25, Default())
+	s.ClampToTotal()
+	if s.Page != 3 || s.Offset != 20 || s.Limit != 10 || s.TotalPages != 3 {
+		t.Errorf("ClampToTotal() = page %d, offset %d, limit %d, total pages %d, want 3, 20, 10, 3", s.Page, s.Offset, s.Limit, s.TotalPages)
+	}
+
+	// Pages within the total are kept.
+	s = BuildSet(2, 10, 25, Default())
+	s.ClampToTotal()
+	if s.Page != 2 || s.Offset != 10 {
+		t.Errorf("ClampToTotal() within total = page %d, offset %d, want 2, 10", s.Page, s.Offset)
+	}
+}
+
+func TestRebaseForPerPage(t *testing.T) {
+	tests := []struct {
+		page, perPage, newPerPage int
+		want                      int
+	}{
+		{5, 10, 25, 2},
+		{6, 10, 25, 3},
+		{2, 25, 10, 3},
+		{1, 10, 25, 1},
+	}
+	for _, tc := range tests {
+		s := New(Default()).New(tc.page, tc.perPage)
+		got := s.RebaseForPerPage(tc.newPerPage)
+		if got != tc.want {
+			t.Errorf("page %d of %d: RebaseForPerPage(%d) = %d, want %d", tc.page, tc.perPage, tc.newPerPage, got, tc.want)
+		}
+
+		// The new page holds the first item of the current page.
+		if first := (got - 1) * tc.newPerPage; s.Offset < first || s.Offset >= first+tc.newPerPage {
+			t.Errorf("page %d of %d: page %d does not hold offset %d", tc.page, tc.perPage, got, s.Offset)
+		}
+	}
+}
+
+func TestTimeRange(t *testing.T) {
+	p := New(Default())
+
+	// 10 hours in hourly buckets, 4 per page.
+	tests := []struct {
+		page       int
+		start, end int64
+	}{
+		{1, 1000, 1000 + 4*3600},
+		{2, 1000 + 4*3600, 1000 + 8*3600},
+		{3, 1000 + 8*3600, 1000 + 10*3600},
+	}
+	for _, tc := range tests {
+		s := p.NewTimeBucket(1000, 1000+10*3600, 3600, tc.page, 4)
+		if start, end := s.TimeRange(); start != tc.start || end != tc.end {
+			t.Errorf("page %d: TimeRange() = %d, %d, want %d, %d", tc.page, start, end, tc.start, tc.end)
+		}
+	}
+}
+
+func TestItemRange(t *testing.T) {
+	tests := []struct {
+		page, total int
+		from, to    int
+		summary     string
+	}{
+		{2, 45, 11, 20, "Item 11–20 of 45"},
+		{5, 45, 41, 45, "Item 41–45 of 45"},
+		{1, 0, 0, 0, "No items"},
+		{6, 45, 0, 0, "No items"},
+	}
+	for _, tc := range tests {
+		s := BuildSet(tc.page, 10, tc.total, Default())
+		if from, to := s.ItemRange(); from != tc.from || to != tc.to {
+			t.Errorf("page %d of total %d: ItemRange() = %d, %d, want %d, %d", tc.page, tc.total, from, to, tc.from, tc.to)
+		}
+		if got := s.ItemSummary(); got != tc.summary {
+			t.Errorf("page %d of total %d: ItemSummary() = %q, want %q", tc.page, tc.total, got, tc.summary)
+		}
+	}
+}
+
+func TestMinGapForEllipsis(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 5
+	o.MinGapForEllipsis = 2
+
+	tests := []struct {
+		page     int
+		want     []int
+		pinFirst bool
+	}{
+		// The gap is only page 2, which is shown instead of an ellipsis.
+		{5, []int{1, 2, 3, 4, 5, 6, 7}, false},
+		// Pages 2 and 3 are hidden behind an ellipsis.
+		{6, []int{4, 5, 6, 7, 8}, true},
+	}
+	for _, tc := range tests {
+		s := BuildSet(tc.page, 10, 200, o)
+		if !slices.Equal(s.Pages, tc.want) || s.PinFirstPage != tc.pinFirst {
+			t.Errorf("page %d: Pages = %v, PinFirstPage = %t, want %v, %t", tc.page, s.Pages, s.PinFirstPage, tc.want, tc.pinFirst)
+		}
+	}
+}
+
+func TestUnionBounds(t *testing.T) {
+	p := New(Default())
+
+	tests := []struct {
+		page                             int
+		offsetA, limitA, offsetB, limitB int
+	}{
+		{1, 0, 10, 0, 0},
+		// Straddles the sources: items 20-24 of A and 0-4 of B.
+		{3, 20, 5, 0, 5},
+		{4, 0, 0, 5, 10},
+		{5, 0, 0, 15, 3},
+	}
+	for _, tc := range tests {
+		s := p.NewUnion(tc.page, 10, 25, 18)
+		offsetA, limitA, offsetB, limitB := s.UnionBounds(25)
+		if offsetA != tc.offsetA || limitA != tc.limitA || offsetB != tc.offsetB || limitB != tc.limitB {
+			t.Errorf("page %d: UnionBounds() = %d, %d, %d, %d, want %d, %d, %d, %d",
+				tc.page, offsetA, limitA, offsetB, limitB, tc.offsetA, tc.limitA, tc.offsetB, tc.limitB)
+		}
+	}
+}
+
+func TestStreamSkipTake(t *testing.T) {
+	s := New(Default()).New(250, 20)
+
+	if skip, take := s.StreamSkipTake(); skip != 4980 || take != 20 {
+		t.Errorf("StreamSkipTake() = %d, %d, want 4980, 20", skip, take)
+	}
+	if got := s.StreamPeek(8); got != 5000 {
+		t.Errorf("StreamPeek(8) = %d, want 5000", got)
+	}
+	if got := s.StreamPeek(0); got != 0 {
+		t.Errorf("StreamPeek(0) = %d, want 0", got)
+	}
+}
+
+func TestNewWithExclusions(t *testing.T) {
+	p := New(Default())
+
+	// Tombstones at source offsets 3, 12 and 25.
+	tombstones := []int{3, 12, 25}
+	excludedBefore := func(offset int) int {
+		n := 0
+		for _, o := range tombstones {
+			if o < offset {
+				n++
+			}
+		}
+		return n
+	}
+
+	tests := []struct {
+		page       int
+		wantOffset int
+	}{
+		{1, 0},
+		// Item 10 is past the tombstone at 3.
+		{2, 11},
+		// Item 20 is past the tombstones at 3 and 12, but before 25.
+		{3, 22},
+		{4, 33},
+	}
+	for _, tc := range tests {
+		s := p.NewWithExclusions(tc.page, 10, excludedBefore)
+		if s.Offset != tc.wantOffset || s.Limit != 10 {
+			t.Errorf("page %d: Offset, Limit = %d, %d, want %d, 10", tc.page, s.Offset, s.Limit, tc.wantOffset)
+		}
+	}
+}
+
+func TestRepaginate(t *testing.T) {
+	o := Default()
+	o.NumPageNums = 5
+
+	s := BuildSet(2, 20, 333, o)
+	s.Repaginate(9)
+	want := BuildSet(9, 20, 333, o)
+
+	if s.Page != want.Page || s.PerPage != want.PerPage || s.Offset != want.Offset || s.Limit != want.Limit ||
+		s.TotalPages != want.TotalPages || !slices.Equal(s.Pages, want.Pages) ||
+		s.PinFirstPage != want.PinFirstPage || s.PinLastPage != want.PinLastPage {
+		t.Errorf("Repaginate(9) = %+v, want %+v", s, want)
+	}
+}
+
+func TestVisibleLimit(t *testing.T) {
+	o := Default()
+	o.VisibleLimit = 100
+	o.NumPageNums = 5
+
+	s := BuildSet(3, 10, 1000, o)
+	if !s.Restricted || s.Total != 1000 || s.TotalPages != 10 {
+		t.Errorf("Restricted, Total, TotalPages = %t, %d, %d, want true, 1000, 10", s.Restricted, s.Total, s.TotalPages)
+	}
+	if s.LastPage != 10 {
+		t.Errorf("LastPage = %d, want 10", s.LastPage)
+	}
+
+	// Pages past the visible items are clamped to the last visible page.
+	s = BuildSet(50, 10, 1000, o)
+	s.ClampToTotal()
+	if s.Page != 10 || s.Offset != 90 {
+		t.Errorf("ClampToTotal() past VisibleLimit = page %d, offset %d, want 10, 90", s.Page, s.Offset)
+	}
+
+	if s := BuildSet(3, 10, 80, o); s.Restricted || s.TotalPages != 8 {
+		t.Errorf("under VisibleLimit: Restricted, TotalPages = %t, %d, want false, 8", s.Restricted, s.TotalPages)
+	}
+}
+
+func TestNewFromUrlStrict(t *testing.T) {
+	o := Default()
+	o.MaxPage = 100
+
+	lo := Default()
+	lo.LimitOffset, lo.LimitParam, lo.OffsetParam = true, "limit", "offset"
+	lo.MaxOffset = 500
+
+	tests := []struct {
+		name    string
+		o       Option
+		q       url.Values
+		wantErr error
+		page    int
+		perPage int
+	}{
+		{"defaults", o, url.Values{}, nil, 1, 10},
+		{"valid", o, url.Values{"page": {"3"}, "per_page": {"20"}}, nil, 3, 20},
+		{"page not a number", o, url.Values{"page": {"abc"}}, ErrInvalidPage, 0, 0},
+		{"page zero", o, url.Values{"page": {"0"}}, ErrInvalidPage, 0, 0},
+		{"page trailing garbage", o, url.Values{"page": {"2x"}}, ErrInvalidPage, 0, 0},
+		{"page over max", o, url.Values{"page": {"101"}}, ErrPageExceedsMax, 0, 0},
+		{"per page not a number", o, url.Values{"per_page": {"10abc"}}, ErrInvalidPerPage, 0, 0},
+		{"per page zero", o, url.Values{"per_page": {"0"}}, ErrInvalidPerPage, 0, 0},
+		{"per page over max", o, url.Values{"per_page": {"51"}}, ErrPerPageExceedsMax, 0, 0},
+		{"all not allowed", o, url.Values{"per_page": {"all"}}, ErrInvalidPerPage, 0, 0},
+		{"offset", lo, url.Values{"offset": {"40"}, "limit": {"20"}}, nil, 3, 20},
+		{"offset negative", lo, url.Values{"offset": {"-1"}}, ErrInvalidOffset, 0, 0},
+		{"offset over max", lo, url.Values{"offset": {"501"}}, ErrOffsetExceedsMax, 0, 0},
+	}
+	for _, tc := range tests {
+		s, err := New(tc.o).NewFromUrlStrict(tc.q)
+		if !errors.Is(err, tc.wantErr) {
+			t.Errorf("%s: NewFromUrlStrict() err = %v, want %v", tc.name, err, tc.wantErr)
+			continue
+		}
+		if err == nil && (s.Page != tc.page || s.PerPage != tc.perPage) {
+			t.Errorf("%s: NewFromUrlStrict() = page %d, per page %d, want %d, %d", tc.name, s.Page, s.PerPage, tc.page, tc.perPage)
+		}
+	}
+}
+
+func TestPin(t *testing.T) {
+	tests := []struct {
+		pin               PinMode
+		page              int
+		pinFirst, pinLast bool
+	}{
+		{PinBoth, 10, true, true},
+		{PinBoth, 1, false, true},
+		{PinBoth, 20, true, false},
+		{PinFirst, 10, true, false},
+		{PinLast, 10, false, true},
+		{PinNone, 10, false, false},
+	}
+	for _, tc := range tests {
+		o := Default()
+		o.NumPageNums = 5
+		o.Pin = tc.pin
+		s := BuildSet(tc.page, 10, 200, o)
+		if s.PinFirstPage != tc.pinFirst || s.PinLastPage != tc.pinLast {
+			t.Errorf("pin %d, page %d: pinned = %t, %t, want %t, %t", tc.pin, tc.page, s.PinFirstPage, s.PinLastPage, tc.pinFirst, tc.pinLast)
+		}
+
+		out := s.HTML("/p?page=%d")
+		if got := strings.Contains(out, `class="pg-page-last"`); got != tc.pinLast {
+			t.Errorf("pin %d, page %d: HTML() = %s, has last page %t, want %t", tc.pin, tc.page, out, got, tc.pinLast)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      func(*Option)
+		wantErrs int
+	}{
+		{"default", func(o *Option) {}, 0},
+		{"default per page", func(o *Option) { o.DefaultPerPage = 0 }, 1},
+		{"max per page", func(o *Option) { o.MaxPerPage = 5 }, 1},
+		{"max per page with AllowAll", func(o *Option) { o.MaxPerPage, o.AllowAll = 5, true }, 0},
+		{"num page nums", func(o *Option) { o.NumPageNums = 0 }, 1},
+		{"negative counts", func(o *Option) { o.MaxPage, o.FullBelow = -1, -2 }, 2},
+		{"per page options", func(o *Option) { o.PerPageOptions = []int{10, 0} }, 1},
+		{"same params", func(o *Option) { o.PerPageParam = "page" }, 1},
+		{"empty params", func(o *Option) { o.PageParam, o.PerPageParam = "", "" }, 0},
+	}
+	for _, tc := range tests {
+		o := Default()
+		tc.set(&o)
+
+		err := o.Validate()
+		n := 0
+		if err != nil {
+			n = len(err.(interface{ Unwrap() []error }).Unwrap())
+		}
+		if n != tc.wantErrs || (err != nil && !errors.Is(err, ErrInvalidOption)) {
+			t.Errorf("%s: Validate() = %v, want %d errors wrapping %v", tc.name, err, tc.wantErrs, ErrInvalidOption)
+		}
+
+		if _, err := NewStrict(o); (err != nil) != (tc.wantErrs > 0) {
+			t.Errorf("%s: NewStrict() err = %v", tc.name, err)
+		}
+	}
+
+	// New normalizes what Validate rejects.
+	o := Default()
+	o.DefaultPerPage, o.MaxPerPage, o.NumPageNums = 0, 5, 0
+	got := New(o).Options()
+	if got.DefaultPerPage != 10 || got.MaxPerPage < got.DefaultPerPage || got.NumPageNums < 1 {
+		t.Errorf("New() options = DefaultPerPage %d, MaxPerPage %d, NumPageNums %d", got.DefaultPerPage, got.MaxPerPage, got.NumPageNums)
+	}
+}
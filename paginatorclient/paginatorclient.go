@@ -0,0 +1,140 @@
+// Package paginatorclient consumes APIs that paginate with RFC 8288 Link
+// headers, such as the ones generated by paginator's Set.LinkHeader, by
+// following the rel="next" links from page to page.
+package paginatorclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Pager fetches the pages of a Link header paginated API one by one.
+type Pager struct {
+	client *http.Client
+	next   string
+}
+
+// New returns a pager that starts at startURL and fetches pages with c.
+// If c is nil, http.DefaultClient is used.
+func New(c *http.Client, startURL string) *Pager {
+	if c == nil {
+		c = http.DefaultClient
+	}
+	return &Pager{client: c, next: startURL}
+}
+
+// More reports whether there is another page to fetch.
+func (p *Pager) More() bool {
+	return p.next != ""
+}
+
+// Next fetches the next page and returns its body. It returns io.EOF after
+// the last page, i.e when the previous page had no rel="next" link.
+// Responses with a non 2xx status are returned as errors.
+func (p *Pager) Next(ctx context.Context) ([]byte, error) {
+	if p.next == "" {
+		return nil, io.EOF
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.next, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("fetching %s: %s", p.next, resp.Status)
+	}
+
+	// Relative links are resolved against the URL of the page.
+	p.next = ""
+	if n, ok := ParseLinkHeader(resp.Header.Get("Link"))["next"]; ok {
+		u, err := resp.Request.URL.Parse(n)
+		if err != nil {
+			return nil, err
+		}
+		p.next = u.String()
+	}
+	return body, nil
+}
+
+// Each fetches every page starting at startURL and calls fn with the body of
+// each, stopping at the first error.
+func Each(ctx context.Context, c *http.Client, startURL string, fn func(body []byte) error) error {
+	p := New(c, startURL)
+	for p.More() {
+		body, err := p.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if err := fn(body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseLinkHeader returns the URLs in an RFC 8288 Link header by their rel,
+// e.g {"next": "https://api.example.com/things?page=3"}. Links with multiple
+// space separated rels are returned under each of them.
+func ParseLinkHeader(h string) map[string]string {
+	out := map[string]string{}
+	for _, link := range splitLinks(h) {
+		parts := strings.Split(link, ";")
+		target := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		target = target[1 : len(target)-1]
+
+		for _, param := range parts[1:] {
+			k, v, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(k), "rel") {
+				continue
+			}
+			for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(v), `"`)) {
+				out[strings.ToLower(rel)] = target
+			}
+		}
+	}
+	return out
+}
+
+// splitLinks splits a Link header into its links on the commas that are not
+// within a <URL>, as URLs may contain commas.
+func splitLinks(h string) []string {
+	var (
+		out   []string
+		start = 0
+		inURL = false
+	)
+	for i, r := range h {
+		switch r {
+		case '<':
+			inURL = true
+		case '>':
+			inURL = false
+		case ',':
+			if !inURL {
+				out = append(out, h[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if s := strings.TrimSpace(h[start:]); s != "" {
+		out = append(out, s)
+	}
+	return out
+}
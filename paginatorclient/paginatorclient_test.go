@@ -0,0 +1,69 @@
+package paginatorclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/purisaurabh/paginator"
+)
+
+func TestParseLinkHeader(t *testing.T) {
+	tests := []struct {
+		h    string
+		want map[string]string
+	}{
+		{"", map[string]string{}},
+		{`<https://x/things?page=2>; rel="next", <https://x/things?page=5>; rel="last"`,
+			map[string]string{"next": "https://x/things?page=2", "last": "https://x/things?page=5"}},
+		{`<https://x/things?ids=1,2&page=2>; rel="next"`, map[string]string{"next": "https://x/things?ids=1,2&page=2"}},
+		{`</things?page=1>; REL="first prev"`, map[string]string{"first": "/things?page=1", "prev": "/things?page=1"}},
+		{`https://x/things; rel="next"`, map[string]string{}},
+	}
+	for _, tc := range tests {
+		if got := ParseLinkHeader(tc.h); !maps.Equal(got, tc.want) {
+			t.Errorf("ParseLinkHeader(%q) = %v, want %v", tc.h, got, tc.want)
+		}
+	}
+}
+
+func TestLinkHeaderRoundTrip(t *testing.T) {
+	var (
+		pg   = paginator.New(paginator.Default())
+		srv  *httptest.Server
+		seen []int
+	)
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s := pg.NewFromRequest(r)
+		s.SetTotal(25)
+		w.Header().Set("Link", s.LinkHeader(srv.URL+r.URL.String()))
+		io.WriteString(w, strconv.Itoa(s.Page))
+	}))
+	defer srv.Close()
+
+	err := Each(context.Background(), srv.Client(), srv.URL+"/things?q=foo", func(body []byte) error {
+		n, err := strconv.Atoi(string(body))
+		seen = append(seen, n)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 3}; !slices.Equal(seen, want) {
+		t.Errorf("fetched pages %v, want %v", seen, want)
+	}
+
+	p := New(srv.Client(), srv.URL+"/things?page=3")
+	if _, err := p.Next(context.Background()); err != nil || p.More() {
+		t.Errorf("last page: Next() err = %v, More() = %t, want nil, false", err, p.More())
+	}
+	if _, err := p.Next(context.Background()); !errors.Is(err, io.EOF) {
+		t.Errorf("past the last page: Next() err = %v, want %v", err, io.EOF)
+	}
+}
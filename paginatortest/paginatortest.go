@@ -0,0 +1,88 @@
+// Package paginatortest provides helpers for testing code that uses
+// paginator: builders for sets at arbitrary positions, assertions on the page
+// number series and golden files for rendered HTML.
+package paginatortest
+
+import (
+	"flag"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/purisaurabh/paginator"
+)
+
+// update makes AssertGolden write the golden files instead of comparing them,
+// e.g go test ./... -paginatortest.update.
+var update = flag.Bool("paginatortest.update", false, "update paginatortest golden files")
+
+// NewSet returns a set for page of total items with perPage items per page
+// and the total set, from a paginator with the Default options changed by
+// opts.
+func NewSet(page, perPage, total int, opts ...paginator.OptionFunc) paginator.Set {
+	s := paginator.NewWithOptions(opts...).New(page, perPage)
+	s.SetTotal(total)
+	return s
+}
+
+// LastPage returns the set for the last page of total items with perPage
+// items per page, like NewSet.
+func LastPage(perPage, total int, opts ...paginator.OptionFunc) paginator.Set {
+	s := NewSet(1, perPage, total, opts...)
+	return NewSet(s.TotalPages, perPage, total, opts...)
+}
+
+// AssertWindow fails the test if the page number series of s isn't want,
+// e.g AssertWindow(t, s, []int{4, 5, 6, 7, 8}).
+func AssertWindow(t testing.TB, s paginator.Set, want []int) {
+	t.Helper()
+	if !slices.Equal(s.Pages, want) {
+		t.Errorf("page numbers = %v, want %v", s.Pages, want)
+	}
+}
+
+// AssertPinned fails the test if the first and last pages of s aren't pinned
+// as given.
+func AssertPinned(t testing.TB, s paginator.Set, first, last bool) {
+	t.Helper()
+	if s.PinFirstPage != first || s.PinLastPage != last {
+		t.Errorf("pinned first, last = %v, %v, want %v, %v", s.PinFirstPage, s.PinLastPage, first, last)
+	}
+}
+
+// AssertBounds fails the test if the Offset and Limit of s aren't the given
+// ones.
+func AssertBounds(t testing.TB, s paginator.Set, offset, limit int) {
+	t.Helper()
+	if s.Offset != offset || s.Limit != limit {
+		t.Errorf("offset, limit = %d, %d, want %d, %d", s.Offset, s.Limit, offset, limit)
+	}
+}
+
+// AssertGolden fails the test if got isn't the content of the golden file at
+// path. With the -paginatortest.update flag, the file is written with got
+// instead.
+func AssertGolden(t testing.TB, got, path string) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("output doesn't match %s:\ngot:  %s\nwant: %s", path, got, want)
+	}
+}
+
+// AssertHTML renders s with HTML for uri and compares it with the golden file
+// at path, see AssertGolden.
+func AssertHTML(t testing.TB, s paginator.Set, uri, path string) {
+	t.Helper()
+	AssertGolden(t, s.HTML(uri), path)
+}
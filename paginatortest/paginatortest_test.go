@@ -0,0 +1,89 @@
+package paginatortest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/purisaurabh/paginator"
+)
+
+// recorder is a testing.TB that records failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	failed bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failed = true
+}
+
+func (r *recorder) Fatal(args ...interface{}) {
+	r.failed = true
+}
+
+func TestBuilders(t *testing.T) {
+	s := NewSet(5, 10, 95, paginator.WithNumPageNums(3))
+	if s.Page != 5 || s.Total != 95 || s.TotalPages != 10 {
+		t.Errorf("NewSet() = page %d, total %d, total pages %d, want 5, 95, 10", s.Page, s.Total, s.TotalPages)
+	}
+	AssertWindow(t, s, []int{4, 5, 6})
+	AssertPinned(t, s, true, true)
+	AssertBounds(t, s, 40, 10)
+
+	last := LastPage(10, 95)
+	if last.Page != 10 {
+		t.Errorf("LastPage() page = %d, want 10", last.Page)
+	}
+}
+
+func TestAssertFailures(t *testing.T) {
+	s := NewSet(5, 10, 95, paginator.WithNumPageNums(3))
+	golden := filepath.Join(t.TempDir(), "html.golden")
+	if err := os.WriteFile(golden, []byte(s.HTML("/p?page=%d")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		assert   func(testing.TB)
+		wantFail bool
+	}{
+		{"window", func(tb testing.TB) { AssertWindow(tb, s, []int{4, 5, 6}) }, false},
+		{"wrong window", func(tb testing.TB) { AssertWindow(tb, s, []int{3, 4, 5}) }, true},
+		{"pinned", func(tb testing.TB) { AssertPinned(tb, s, true, true) }, false},
+		{"wrong pinned", func(tb testing.TB) { AssertPinned(tb, s, false, true) }, true},
+		{"bounds", func(tb testing.TB) { AssertBounds(tb, s, 40, 10) }, false},
+		{"wrong bounds", func(tb testing.TB) { AssertBounds(tb, s, 50, 10) }, true},
+		{"html", func(tb testing.TB) { AssertHTML(tb, s, "/p?page=%d", golden) }, false},
+		{"wrong html", func(tb testing.TB) { AssertHTML(tb, s, "/q?page=%d", golden) }, true},
+		{"missing golden", func(tb testing.TB) { AssertGolden(tb, "", golden+".missing") }, true},
+	}
+	for _, tc := range tests {
+		r := &recorder{TB: t}
+		tc.assert(r)
+		if r.failed != tc.wantFail {
+			t.Errorf("%s: failed = %t, want %t", tc.name, r.failed, tc.wantFail)
+		}
+	}
+}
+
+func TestAssertGoldenUpdate(t *testing.T) {
+	*update = true
+	defer func() { *update = false }()
+
+	golden := filepath.Join(t.TempDir(), "out.golden")
+	AssertGolden(t, "<nav></nav>", golden)
+	if b, err := os.ReadFile(golden); err != nil || string(b) != "<nav></nav>" {
+		t.Errorf("golden file = %q, %v, want %q", b, err, "<nav></nav>")
+	}
+}
+
+func ExampleNewSet() {
+	s := NewSet(5, 10, 95, paginator.WithNumPageNums(3))
+	fmt.Println(s.Pages, s.Offset, s.TotalPages)
+	// Output: [4 5 6] 40 10
+}
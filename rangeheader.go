@@ -0,0 +1,85 @@
+package paginator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// NewFromRange returns a new paginator set from a Range request header in
+// Option.RangeUnit, e.g "items=0-24", as used by PostgREST style APIs. The
+// Offset is the start of the range and the Limit is the size of the range,
+// clamped to MaxPerPage. An empty header returns the default first page.
+// Errors wrap ErrInvalidRange.
+func (p *Paginator) NewFromRange(header string) (Set, error) {
+	if header == "" {
+		return p.New(1, 0), nil
+	}
+
+	spec, ok := strings.CutPrefix(header, p.o.RangeUnit+"=")
+	if !ok {
+		return Set{}, fmt.Errorf("%w: %q", ErrInvalidRange, header)
+	}
+
+	from, to, ok := strings.Cut(spec, "-")
+	start, err := p.atoi(strings.TrimSpace(from))
+	if !ok || err != nil || start < 0 {
+		return Set{}, fmt.Errorf("%w: %q", ErrInvalidRange, header)
+	}
+
+	// An open range ("items=25-") gets the default per page.
+	perPage := 0
+	if to = strings.TrimSpace(to); to != "" {
+		end, err := p.atoi(to)
+		if err != nil || end < start {
+			return Set{}, fmt.Errorf("%w: %q", ErrInvalidRange, header)
+		}
+		perPage = end - start + 1
+	}
+
+	return p.newFromOffset(start, perPage), nil
+}
+
+// NewFromRangeRequest returns a new paginator set from the Range header of
+// an HTTP request like NewFromRange.
+func (p *Paginator) NewFromRangeRequest(r *http.Request) (Set, error) {
+	return p.NewFromRange(r.Header.Get("Range"))
+}
+
+// ContentRange returns the Content-Range header value for the set's page,
+// e.g "items 0-24/319", or "items */319" if the page is empty.
+// Call it after SetTotal.
+func (s *Set) ContentRange() string {
+	from, to := s.ItemRange()
+	if from == 0 {
+		return s.pg.o.RangeUnit + " */" + strconv.Itoa(s.itemTotal())
+	}
+	return fmt.Sprintf("%s %d-%d/%d", s.pg.o.RangeUnit, from-1, to-1, s.itemTotal())
+}
+
+// RangeStatus returns the HTTP status for responding to a range request for
+// the set's page: 206 Partial Content if the page holds only some of the
+// items, 200 OK if it holds all of them (or there are none) and 416 Range Not Satisfiable if the
+// page is past the end. Call it after SetTotal.
+func (s *Set) RangeStatus() int {
+	from, to := s.ItemRange()
+	switch {
+	case s.Total == 0:
+		return http.StatusOK
+	case from == 0:
+		return http.StatusRequestedRangeNotSatisfiable
+	case from == 1 && to == s.itemTotal():
+		return http.StatusOK
+	default:
+		return http.StatusPartialContent
+	}
+}
+
+// WriteContentRange sets the Content-Range and Accept-Ranges headers of the
+// response and writes the header with RangeStatus.
+func (s *Set) WriteContentRange(w http.ResponseWriter) {
+	w.Header().Set("Accept-Ranges", s.pg.o.RangeUnit)
+	w.Header().Set("Content-Range", s.ContentRange())
+	w.WriteHeader(s.RangeStatus())
+}
@@ -0,0 +1,231 @@
+// Package relaypaginator implements GraphQL Relay style connections
+// (https://relay.dev/graphql/connections.htm) on top of paginator, with both
+// offset based cursors and keyset cursors.
+package relaypaginator
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/purisaurabh/paginator"
+)
+
+// Args are the pagination arguments of a connection field.
+type Args struct {
+	First  *int
+	After  *string
+	Last   *int
+	Before *string
+}
+
+// PageInfo is the PageInfo of a connection.
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+// Edge is an edge of a connection.
+type Edge[T any] struct {
+	Node   T      `json:"node"`
+	Cursor string `json:"cursor"`
+}
+
+// Connection is a connection with its edges and page info.
+type Connection[T any] struct {
+	Edges      []Edge[T] `json:"edges"`
+	PageInfo   PageInfo  `json:"pageInfo"`
+	TotalCount int       `json:"totalCount"`
+}
+
+// Errors returned for invalid arguments.
+var (
+	ErrNegativeFirst = errors.New("first must not be negative")
+	ErrNegativeLast  = errors.New("last must not be negative")
+	ErrFirstAndLast  = errors.New("first and last must not be used together")
+
+	// ErrInvalidCursor is paginator.ErrInvalidCursor, which the errors for
+	// invalid after and before cursors wrap.
+	ErrInvalidCursor = paginator.ErrInvalidCursor
+)
+
+// validate checks the arguments as required by the spec.
+func (a Args) validate() error {
+	if a.First != nil && *a.First < 0 {
+		return ErrNegativeFirst
+	}
+	if a.Last != nil && *a.Last < 0 {
+		return ErrNegativeLast
+	}
+	return nil
+}
+
+// NewSet returns the set for the edges selected by the arguments out of a
+// list of total items, with offset based cursors. The offset is not known
+// for last without before until the total is, which is why it has to be
+// counted first. first and last are clamped to MaxPerPage and the default
+// per page is used when neither is given. When no edges are selected, the
+// Offset is total so that the page is empty.
+func NewSet(p *paginator.Paginator, a Args, total int) (paginator.Set, error) {
+	if err := a.validate(); err != nil {
+		return paginator.Set{}, err
+	}
+
+	start, end := 0, total
+	if a.After != nil {
+		off, err := decodeOffset(p, *a.After)
+		if err != nil {
+			return paginator.Set{}, err
+		}
+		start = max(start, off+1)
+	}
+	if a.Before != nil {
+		off, err := decodeOffset(p, *a.Before)
+		if err != nil {
+			return paginator.Set{}, err
+		}
+		end = min(end, off)
+	}
+
+	perPage := func(n int) int {
+		return p.New(1, n).PerPage
+	}
+	switch {
+	case a.First != nil:
+		end = min(end, start+min(*a.First, perPage(*a.First)))
+		if a.Last != nil {
+			start = max(start, end-min(*a.Last, perPage(*a.Last)))
+		}
+	case a.Last != nil:
+		start = max(start, end-min(*a.Last, perPage(*a.Last)))
+	default:
+		end = min(end, start+p.Options().DefaultPerPage)
+	}
+
+	if start >= end {
+		start, end = total, total
+	}
+
+	s := p.New(1, end-start)
+	if s.PerPage > 0 {
+		s.Page = start/s.PerPage + 1
+	}
+	s.Offset, s.Limit = start, end-start
+	s.SetTotal(total)
+	return s, nil
+}
+
+// NewConnection returns the connection for the items of a set created with
+// NewSet, with offset based cursors.
+func NewConnection[T any](p *paginator.Paginator, s paginator.Set, items []T) Connection[T] {
+	c := Connection[T]{
+		Edges:      make([]Edge[T], len(items)),
+		TotalCount: s.Total,
+		PageInfo: PageInfo{
+			HasPreviousPage: s.Offset > 0,
+			HasNextPage:     s.Offset+len(items) < s.Total,
+		},
+	}
+
+	for i, it := range items {
+		c.Edges[i] = Edge[T]{Node: it, Cursor: encodeOffset(p, s.Offset+i)}
+	}
+	if len(items) > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[len(items)-1].Cursor
+	}
+	return c
+}
+
+// encodeOffset returns the cursor of the item at offset.
+func encodeOffset(p *paginator.Paginator, offset int) string {
+	return p.EncodeCursor(paginator.Cursor{Keys: []interface{}{int64(offset)}})
+}
+
+// decodeOffset returns the offset of an offset based cursor.
+func decodeOffset(p *paginator.Paginator, cursor string) (int, error) {
+	c, err := p.DecodeCursor(cursor)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(c.Keys) != 1 {
+		return 0, fmt.Errorf("%w: not an offset cursor", ErrInvalidCursor)
+	}
+
+	off, ok := c.Keys[0].(int64)
+	if !ok || off < 0 {
+		return 0, fmt.Errorf("%w: not an offset cursor", ErrInvalidCursor)
+	}
+	return int(off), nil
+}
+
+// NewCursor returns the keyset cursor for the arguments, to be used with
+// paginator.Keyset. after and first page forwards and before and last page
+// backwards, so they can't be mixed. first and last are clamped to MaxPerPage.
+// A first or last of 0 gets the default per page like a missing one, as a
+// Cursor's PerPage of 0 would fetch all the items.
+func NewCursor(p *paginator.Paginator, a Args) (paginator.Cursor, error) {
+	if err := a.validate(); err != nil {
+		return paginator.Cursor{}, err
+	}
+	if a.First != nil && a.Last != nil {
+		return paginator.Cursor{}, ErrFirstAndLast
+	}
+
+	var (
+		token  *string
+		count  *int
+		before = a.Before != nil || a.Last != nil
+	)
+	if before {
+		token, count = a.Before, a.Last
+	} else {
+		token, count = a.After, a.First
+	}
+
+	// An empty cursor for the first page.
+	c, _ := p.NewFromCursor(url.Values{})
+	if token != nil {
+		var err error
+		if c, err = p.DecodeCursor(*token); err != nil {
+			return paginator.Cursor{}, err
+		}
+	}
+
+	n := 0
+	if count != nil {
+		n = *count
+	}
+	c.Before = before
+	c.PerPage = p.New(1, n).PerPage
+	return c, nil
+}
+
+// CursorPageInfo returns the page info for a page fetched with a keyset
+// cursor created with NewCursor. hasMore reports whether there are more items
+// past the page in the direction of paging, e.g by fetching one item more
+// than PerPage. startKeys and endKeys are the sort keys of the first and last
+// items of the page, which are nil when the page is empty.
+func CursorPageInfo(c paginator.Cursor, hasMore bool, startKeys, endKeys []interface{}) PageInfo {
+	pi := PageInfo{}
+	if c.Before {
+		pi.HasPreviousPage = hasMore
+		pi.HasNextPage = !c.IsFirst()
+	} else {
+		pi.HasNextPage = hasMore
+		pi.HasPreviousPage = !c.IsFirst()
+	}
+
+	if startKeys != nil {
+		s := c.Next(startKeys...)
+		pi.StartCursor = &s
+	}
+	if endKeys != nil {
+		e := c.Next(endKeys...)
+		pi.EndCursor = &e
+	}
+	return pi
+}
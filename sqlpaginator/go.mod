@@ -0,0 +1,8 @@
+module github.com/purisaurabh/paginator/sqlpaginator
+
+go 1.23
+
+require (
+	github.com/jmoiron/sqlx v1.4.0
+	github.com/purisaurabh/paginator v0.1.0
+)
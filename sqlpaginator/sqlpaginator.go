@@ -0,0 +1,71 @@
+// Package sqlpaginator runs paginated queries with database/sql and sqlx,
+// executing both the count query for the total and the query for the page.
+//
+// The page is selected by appending LIMIT and OFFSET clauses to the query,
+// which is supported by e.g PostgreSQL, MySQL and SQLite.
+package sqlpaginator
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/purisaurabh/paginator"
+)
+
+// Queryer runs queries. It is implemented by *sql.DB, *sql.Tx, *sql.Conn
+// and their sqlx equivalents.
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Count counts the rows returned by query and sets the result as the total
+// of the set.
+func Count(ctx context.Context, db Queryer, s *paginator.Set, query string, args ...interface{}) error {
+	var total int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM ("+query+") AS paginator_count", args...).Scan(&total); err != nil {
+		return err
+	}
+
+	s.SetTotal(total)
+	return nil
+}
+
+// Query sets the total of the set with Count and returns the rows of query
+// on the set's page.
+func Query(ctx context.Context, db Queryer, s *paginator.Set, query string, args ...interface{}) (*sql.Rows, error) {
+	if err := Count(ctx, db, s, query, args...); err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, PageQuery(*s, query), args...)
+}
+
+// Select sets the total of the set with Count and scans the rows of query on
+// the set's page into dest, which is a pointer to a slice, using sqlx.
+func Select(ctx context.Context, db *sqlx.DB, s *paginator.Set, dest interface{}, query string, args ...interface{}) error {
+	if err := Count(ctx, db, s, query, args...); err != nil {
+		return err
+	}
+	return db.SelectContext(ctx, dest, PageQuery(*s, query), args...)
+}
+
+// PageQuery returns query with the LIMIT and OFFSET clauses for the set's
+// page appended. The values are inlined as they are integers computed by
+// the paginator, which avoids depending on the driver's placeholder style.
+// MySQL and SQLite don't accept OFFSET without LIMIT, so a set without a
+// limit gets the largest LIMIT all of them accept when it has an offset.
+func PageQuery(s paginator.Set, query string) string {
+	switch {
+	case s.Limit > 0 || s.Empty:
+		query += " LIMIT " + strconv.Itoa(s.Limit)
+	case s.Offset > 0:
+		query += " LIMIT " + strconv.FormatInt(math.MaxInt64, 10)
+	}
+	if s.Offset > 0 {
+		query += " OFFSET " + strconv.Itoa(s.Offset)
+	}
+	return query
+}
@@ -0,0 +1,26 @@
+package sqlpaginator
+
+import (
+	"testing"
+
+	"github.com/purisaurabh/paginator"
+)
+
+func TestPageQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		s    paginator.Set
+		want string
+	}{
+		{"page", paginator.BuildSet(3, 10, 100, paginator.Default()), "SELECT * FROM t LIMIT 10 OFFSET 20"},
+		{"first page", paginator.BuildSet(1, 10, 100, paginator.Default()), "SELECT * FROM t LIMIT 10"},
+		{"no limit", paginator.Set{}, "SELECT * FROM t"},
+		{"offset without limit", paginator.Set{Offset: 20}, "SELECT * FROM t LIMIT 9223372036854775807 OFFSET 20"},
+		{"empty", paginator.Set{Empty: true}, "SELECT * FROM t LIMIT 0"},
+	}
+	for _, tc := range tests {
+		if got := PageQuery(tc.s, "SELECT * FROM t"); got != tc.want {
+			t.Errorf("%s: PageQuery() = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
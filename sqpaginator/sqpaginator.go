@@ -0,0 +1,38 @@
+// Package sqpaginator integrates paginator with the Squirrel SQL query builder.
+package sqpaginator
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/purisaurabh/paginator"
+)
+
+// Apply returns the select builder with the LIMIT and OFFSET of the set's page.
+// An Empty set gets LIMIT 0.
+func Apply(s paginator.Set, sb sq.SelectBuilder) sq.SelectBuilder {
+	if s.Limit > 0 || s.Empty {
+		sb = sb.Limit(uint64(s.Limit))
+	}
+	if s.Offset > 0 {
+		sb = sb.Offset(uint64(s.Offset))
+	}
+	return sb
+}
+
+// ApplyCursor returns the select builder with the keyset conditions, order
+// and limit of the cursor. The keyset must use "?" placeholders, i.e have no
+// Placeholder set, as Squirrel converts them with its own PlaceholderFormat.
+func ApplyCursor(c paginator.Cursor, ks *paginator.Keyset, sb sq.SelectBuilder) (sq.SelectBuilder, error) {
+	where, args, err := ks.Where(c)
+	if err != nil {
+		return sb, err
+	}
+
+	if where != "" {
+		sb = sb.Where(where, args...)
+	}
+	sb = sb.OrderBy(ks.OrderBy(c))
+	if c.PerPage > 0 {
+		sb = sb.Limit(uint64(c.PerPage))
+	}
+	return sb, nil
+}
@@ -0,0 +1,54 @@
+package paginator
+
+import (
+	"errors"
+	"net/url"
+)
+
+// StripeList is a Stripe style list response envelope.
+type StripeList[T any] struct {
+	Object  string `json:"object"`
+	URL     string `json:"url"`
+	HasMore bool   `json:"has_more"`
+	Data    []T    `json:"data"`
+}
+
+// NewFromStripe returns a new cursor from Stripe style starting_after,
+// ending_before and limit query params. Unlike other cursors, the params
+// hold plain object IDs instead of tokens, which become the single key of
+// the cursor. ending_before makes a before cursor. limit is clamped to
+// MaxPerPage, and rejected if invalid in ParseStrict mode.
+func (p *Paginator) NewFromStripe(q url.Values) (Cursor, error) {
+	var (
+		after  = q.Get("starting_after")
+		before = q.Get("ending_before")
+	)
+	if after != "" && before != "" {
+		return Cursor{}, errors.New("starting_after and ending_before can't be used together")
+	}
+
+	limit, err := p.strictPerPage(q.Get("limit"))
+	if err != nil {
+		return Cursor{}, err
+	}
+	c := Cursor{PerPage: p.New(1, limit).PerPage, pg: p}
+	switch {
+	case after != "":
+		c.Keys = []interface{}{after}
+	case before != "":
+		c.Keys = []interface{}{before}
+		c.Before = true
+	}
+	return c, nil
+}
+
+// NewStripeList returns a Stripe style list of the items fetched for a
+// cursor. hasMore reports whether there are more items past the page in the
+// direction of paging, e.g by fetching one item more than PerPage. A nil
+// slice is wrapped as an empty one so that it is encoded as [] in JSON.
+func NewStripeList[T any](url string, items []T, hasMore bool) StripeList[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return StripeList[T]{Object: "list", URL: url, HasMore: hasMore, Data: items}
+}
@@ -0,0 +1,165 @@
+package paginator
+
+import (
+	"bytes"
+	"html/template"
+	"io"
+)
+
+// DefaultTemplate is the template HTML prints pagination with by default.
+// It is executed with TemplateData.
+var DefaultTemplate = template.Must(template.New("paginator").Parse(
+	`<nav class="{{.Classes.Wrapper}}" aria-label="Pagination"{{if .RTL}} dir="rtl"{{end}}>` +
+		`{{range .Items}}` +
+		`{{if .Ellipsis}}<span class="{{.Class}}" aria-hidden="true">...</span>` +
+		`{{else if .Label}}<a class="{{.Class}}" href="{{.URL}}"{{with .Rel}} rel="{{.}}"{{end}}>{{.Label}}</a>` +
+		`{{else if .Link}}<a class="{{.Class}}" href="{{.URL}}"{{with .Rel}} rel="{{.}}"{{end}}{{if .Current}} aria-current="page"{{end}}>{{.Page}}</a>` +
+		`{{else}}<span class="{{.Class}}"{{if .Current}} aria-current="page"{{end}}>{{.Page}}</span>` +
+		`{{end}} {{end}}` +
+		`</nav>`))
+
+// TemplateData is the data pagination templates are executed with.
+type TemplateData struct {
+	// Set is the set the pagination is printed for.
+	Set *Set
+
+	// RTL is set for right-to-left pagination. Items are in logical order, the
+	// template sets dir="rtl" for the browser to lay them out.
+	RTL bool
+
+	// Classes are the CSS class names from Option.CSSClasses.
+	Classes CSSClasses
+
+	// Items are the items of the pagination in the order they are printed:
+	// the first and previous buttons, the pinned first page, the page number
+	// series, the pinned last page and the next and last buttons, with
+	// ellipses between the pages. Buttons are only included if they have a
+	// label in Option.Labels.
+	Items []TemplateItem
+}
+
+// TemplateItem is a page number, a button or an ellipsis of a pagination.
+type TemplateItem struct {
+	// Page is the page number, or 0 for an ellipsis.
+	Page int
+
+	// URL is the URL of the page.
+	URL string
+
+	// Class is the CSS class of the item, e.g "pg-page pg-selected".
+	// The class names are set with Option.CSSClasses.
+	Class string
+
+	// Current is set for the current page.
+	Current bool
+
+	// Rel is "prev" or "next" for the pages before and after the current
+	// one and empty otherwise.
+	Rel string
+
+	// Label is the label of a first, previous, next or last button.
+	// It is empty for page numbers.
+	Label string
+
+	// Ellipsis is set for an ellipsis between page numbers.
+	Ellipsis bool
+
+	// Link is set for items that are printed as links, which are all pages
+	// except the current one if Option.SelectedAsSpan is set.
+	Link bool
+}
+
+// RenderWith prints the pagination by executing t with TemplateData for the
+// set, where the page URLs are uri formatted with the page number.
+func (s *Set) RenderWith(t *template.Template, uri string) (string, error) {
+	var b bytes.Buffer
+	if err := t.Execute(&b, s.templateData(uri, s.pg.o.Labels)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// Render writes the pagination to w by executing Option.Template, or
+// DefaultTemplate if it isn't set, as HTML does. The output is streamed to w,
+// e.g an http.ResponseWriter, without being buffered.
+func (s *Set) Render(w io.Writer, uri string) error {
+	return s.template().Execute(w, s.templateData(uri, s.pg.o.Labels))
+}
+
+// HTMLLabels prints pagination as HTML like HTML, with first, previous, next
+// and last buttons labelled by l instead of Option.Labels, e.g to print the
+// labels in the language of the request.
+func (s *Set) HTMLLabels(uri string, l Labels) string {
+	var b bytes.Buffer
+	if err := s.template().Execute(&b, s.templateData(uri, l)); err != nil {
+		return ""
+	}
+	return b.String()
+}
+
+// template returns the template to print the pagination with.
+func (s *Set) template() *template.Template {
+	if s.pg.o.Template != nil {
+		return s.pg.o.Template
+	}
+	return DefaultTemplate
+}
+
+// templateData returns the data to execute pagination templates with, with
+// buttons for the non-empty labels in l.
+func (s *Set) templateData(uri string, l Labels) TemplateData {
+	var (
+		c     = s.pg.o.CSSClasses
+		items []TemplateItem
+	)
+	page := func(p int, class string) TemplateItem {
+		it := TemplateItem{Page: p, URL: s.pageURL(uri, p), Class: class, Current: p == s.Page, Link: true}
+		switch p {
+		case s.Page - 1:
+			it.Rel = "prev"
+		case s.Page + 1:
+			it.Rel = "next"
+		}
+		return it
+	}
+
+	button := func(p int, label, class, rel string) {
+		if label != "" {
+			items = append(items, TemplateItem{Page: p, URL: s.pageURL(uri, p), Class: class, Rel: rel, Label: label, Link: true})
+		}
+	}
+
+	last := s.lastPage()
+	if s.Page > 1 {
+		button(1, l.First, c.FirstButton, "")
+		button(min(s.Page-1, last), l.Prev, c.Prev, "prev")
+	}
+	if s.PinFirstPage {
+		items = append(items,
+			page(1, c.First),
+			TemplateItem{Class: c.EllipsisFirst, Ellipsis: true})
+	}
+	for i, p := range s.Pages {
+		if s.gapBefore(i) {
+			items = append(items, TemplateItem{Class: c.Ellipsis, Ellipsis: true})
+		}
+
+		it := page(p, c.Page)
+		if it.Current {
+			it.Class += " " + c.Selected
+			it.Link = !s.pg.o.SelectedAsSpan
+		}
+		items = append(items, it)
+	}
+	if s.PinLastPage {
+		items = append(items,
+			TemplateItem{Class: c.EllipsisLast, Ellipsis: true},
+			page(s.TotalPages, c.Last))
+	}
+	if s.Page < last {
+		button(s.Page+1, l.Next, c.Next, "next")
+		button(last, l.Last, c.LastButton, "")
+	}
+
+	return TemplateData{Set: s, RTL: s.pg.o.RTL, Classes: c, Items: items}
+}
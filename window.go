@@ -0,0 +1,111 @@
+package paginator
+
+// WindowStrategy chooses the page numbers shown in the page number series of
+// a pagination, see Option.Window.
+type WindowStrategy interface {
+	// Window returns the page numbers to show for page out of numPages
+	// pages, in ascending order. The first and last pages are pinned if the
+	// series doesn't include them, and gaps in the series are printed as
+	// ellipses.
+	Window(page, numPages int) []int
+}
+
+// CenteredWindow shows Size page numbers centered on the current page, e.g
+// (1, ..., 6, 7, 8, 9, 10, ..., 20) for page 8 with a Size of 5. It is the
+// default strategy, with a Size of Option.NumPageNums.
+type CenteredWindow struct {
+	Size int
+}
+
+// Window implements WindowStrategy.
+func (w CenteredWindow) Window(page, numPages int) []int {
+	half := w.Size / 2
+
+	var (
+		first = page - half
+		last  = page + half
+	)
+
+	if first < 1 {
+		first = 1
+	}
+
+	if last > numPages {
+		last = numPages
+	}
+
+	if numPages > w.Size {
+		if last < numPages && page <= half {
+			last = first + w.Size - 1
+		}
+		if page > numPages-half {
+			first = last - w.Size
+		}
+	}
+	return pageRange(first, last)
+}
+
+// LeadingWindow shows Size page numbers starting at the page before the
+// current one, leading with the pages that come next like search engine
+// results, e.g (1, ..., 7, 8, 9, 10, 11, ..., 20) for page 8 with a Size of 5.
+// Near the last page the window is shifted back to keep Size page numbers.
+type LeadingWindow struct {
+	Size int
+}
+
+// Window implements WindowStrategy.
+func (w LeadingWindow) Window(page, numPages int) []int {
+	first := max(page-1, 1)
+	last := min(first+w.Size-1, numPages)
+	first = max(min(first, last-w.Size+1), 1)
+	return pageRange(first, last)
+}
+
+// EdgesWindow always shows the first and last Outer page numbers and Inner
+// page numbers on either side of the current page, with ellipses between
+// them, e.g (1, 2, ..., 6, 7, 8, 9, 10, ..., 19, 20) for page 8 with an Inner
+// of 2 and an Outer of 2. It is the strategy of Option.InnerWindow and
+// Option.OuterWindow.
+type EdgesWindow struct {
+	Inner int
+	Outer int
+}
+
+// Window implements WindowStrategy.
+func (w EdgesWindow) Window(page, numPages int) []int {
+	var (
+		out  []int
+		last int
+	)
+	add := func(first, to int) {
+		for p := max(first, last+1); p <= min(to, numPages); p++ {
+			out = append(out, p)
+			last = p
+		}
+	}
+
+	add(1, w.Outer)
+	add(page-w.Inner, page+w.Inner)
+	add(numPages-w.Outer+1, numPages)
+	return out
+}
+
+// window returns the strategy to generate page numbers with.
+func (p *Paginator) window() WindowStrategy {
+	if p.o.Window != nil {
+		return p.o.Window
+	}
+	if p.o.InnerWindow > 0 || p.o.OuterWindow > 0 {
+		return EdgesWindow{Inner: p.o.InnerWindow, Outer: p.o.OuterWindow}
+	}
+	return CenteredWindow{Size: p.o.NumPageNums}
+}
+
+// pageRange returns the page numbers from first to last.
+func pageRange(first, last int) []int {
+	out := make([]int, 0, max(last-first+1, 0))
+	for i := first; i <= last; i++ {
+		out = append(out, i)
+	}
+	return out
+}
@@ -0,0 +1,66 @@
+package paginator
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestWindowStrategies(t *testing.T) {
+	tests := []struct {
+		name           string
+		w              WindowStrategy
+		page, numPages int
+		want           []int
+	}{
+		{"centered", CenteredWindow{Size: 5}, 8, 20, []int{6, 7, 8, 9, 10}},
+		{"centered first", CenteredWindow{Size: 5}, 1, 20, []int{1, 2, 3, 4, 5}},
+		{"centered last", CenteredWindow{Size: 5}, 20, 20, []int{15, 16, 17, 18, 19, 20}},
+		{"centered few pages", CenteredWindow{Size: 5}, 2, 3, []int{1, 2, 3}},
+		{"leading", LeadingWindow{Size: 5}, 8, 20, []int{7, 8, 9, 10, 11}},
+		{"leading first", LeadingWindow{Size: 5}, 1, 20, []int{1, 2, 3, 4, 5}},
+		{"leading last", LeadingWindow{Size: 5}, 20, 20, []int{16, 17, 18, 19, 20}},
+		{"leading few pages", LeadingWindow{Size: 5}, 2, 3, []int{1, 2, 3}},
+		{"edges", EdgesWindow{Inner: 2, Outer: 2}, 8, 20, []int{1, 2, 6, 7, 8, 9, 10, 19, 20}},
+		{"edges overlapping", EdgesWindow{Inner: 2, Outer: 2}, 3, 20, []int{1, 2, 3, 4, 5, 19, 20}},
+		{"edges few pages", EdgesWindow{Inner: 2, Outer: 2}, 2, 3, []int{1, 2, 3}},
+	}
+	for _, tc := range tests {
+		if got := tc.w.Window(tc.page, tc.numPages); !slices.Equal(got, tc.want) {
+			t.Errorf("%s: Window(%d, %d) = %v, want %v", tc.name, tc.page, tc.numPages, got, tc.want)
+		}
+	}
+}
+
+func TestOptionWindow(t *testing.T) {
+	o := Default()
+	o.Window = LeadingWindow{Size: 3}
+	s := BuildSet(8, 10, 200, o)
+	if want := []int{7, 8, 9}; !slices.Equal(s.Pages, want) || !s.PinFirstPage || !s.PinLastPage {
+		t.Errorf("LeadingWindow: Pages = %v, pinned %t, %t, want %v, true, true", s.Pages, s.PinFirstPage, s.PinLastPage, want)
+	}
+}
+
+func TestInnerOuterWindow(t *testing.T) {
+	o := Default()
+	o.InnerWindow, o.OuterWindow = 1, 2
+
+	s := BuildSet(8, 10, 200, o)
+	if want := []int{1, 2, 7, 8, 9, 19, 20}; !slices.Equal(s.Pages, want) {
+		t.Errorf("Pages = %v, want %v", s.Pages, want)
+	}
+	if s.PinFirstPage || s.PinLastPage {
+		t.Errorf("pinned = %t, %t, want the outer window instead of pins", s.PinFirstPage, s.PinLastPage)
+	}
+
+	out := s.HTML("/p?page=%d")
+	if n := strings.Count(out, `aria-hidden="true">...</span>`); n != 2 {
+		t.Errorf("HTML() = %s, has %d ellipses, want 2", out, n)
+	}
+
+	// Only the outer window without an inner one.
+	o.InnerWindow = 0
+	if s := BuildSet(8, 10, 200, o); !slices.Equal(s.Pages, []int{1, 2, 8, 19, 20}) {
+		t.Errorf("OuterWindow only: Pages = %v, want [1 2 8 19 20]", s.Pages)
+	}
+}